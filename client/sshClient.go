@@ -123,11 +123,7 @@ func (p *SSHProvisioner) Close() error {
 
 // Run runs ssh command
 func (p *SSHProvisioner) Run(cmd string) (string, error) {
-	// Sanitize the command for possible passwords in the logs
-	re := regexp.MustCompile(`--password\s+\S+`)
-	reNext := regexp.MustCompile(`:(\S+)`)
-	sanitizedOutput := re.ReplaceAllString(cmd, "--password ****")
-	sanitizedOutput = reNext.ReplaceAllString(sanitizedOutput, ":***")
+	sanitizedOutput := SanitizeCommand(cmd)
 	p.logger.Printf("Running command: %s", sanitizedOutput)
 
 	session, err := p.sshClient.NewSession()
@@ -142,6 +138,20 @@ func (p *SSHProvisioner) Run(cmd string) (string, error) {
 	return string(output), nil
 }
 
+// SanitizeCommand masks the possible passwords in a command so that it can be logged
+func SanitizeCommand(cmd string) string {
+	// the password argument may be shell quoted and contain whitespace
+	re := regexp.MustCompile(`--password\s+('([^']|'\\'')*'|\S+)`)
+	reNext := regexp.MustCompile(`:(\S+)`)
+	sanitizedOutput := re.ReplaceAllString(cmd, "--password ****")
+	return reNext.ReplaceAllString(sanitizedOutput, ":***")
+}
+
+// ShellQuote quotes the given string as a single argument for a POSIX shell
+func ShellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
 // RunWithDir runs ssh command with directory
 func (p *SSHProvisioner) RunWithDir(dir, cmd string) (string, error) {
 	return p.Run(fmt.Sprintf("cd %s && %s", dir, cmd))
@@ -153,3 +153,11 @@ func TestSshClientMWrongPass(t *testing.T) {
 		return
 	}
 }
+
+func TestSanitizeCommand(t *testing.T) {
+	secret := `ab c'$(reboot)'d`
+	cmd := "./drv_cfg --set_mdm_password --ip 10.1.1.1 --port 6611 --password " + ShellQuote(secret) + " --file x"
+	assert.Equal(t, "./drv_cfg --set_mdm_password --ip 10.1.1.1 --port 6611 --password **** --file x", SanitizeCommand(cmd))
+	assert.Equal(t, "scli --login --password ****", SanitizeCommand("scli --login --password Pass123"))
+	assert.Equal(t, `'ab c'\''$(reboot)'\''d'`, ShellQuote(secret))
+}
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# Compare the current default catalog with the catalog to switch to
+data "powerflex_firmware_repository_compare" "catalogs" {
+  source_repository_id = "8aaa80658cd602e0018cd996a1c91bdc"
+  target_repository_id = "8aaa80788b5755d1018b576126d51ba3"
+}
+
+# Compare the components installed on the nodes of a resource group with a catalog, including the unchanged components
+data "powerflex_firmware_repository_compare" "resource_group" {
+  resource_group_id    = "8aaa03a88de961fa018de96a88d80008"
+  target_repository_id = "8aaa80788b5755d1018b576126d51ba3"
+  include_unchanged    = true
+}
+
+output "downgraded_components" {
+  value = [for diff in data.powerflex_firmware_repository_compare.catalogs.component_diffs : diff if diff.change == "downgrade"]
+}
+
+output "resource_group_diffs" {
+  value = data.powerflex_firmware_repository_compare.resource_group.component_diffs
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# Get all network details present on PowerFlex Manager
+data "powerflex_network" "example1" {
+}
+
+# if a filter is of type string it has the ability to allow regular expressions
+# data "powerflex_network" "network_filter_regex" {
+#   filter{
+#     name = ["^PFlex-.*$"]
+#   }
+# }
+
+# output "networkFilterRegexResult"{
+#  value = data.powerflex_network.network_filter_regex.network_details
+# }
+
+// If multiple filter fields are provided then it will show the intersection of all of those fields.
+// If there is no intersection between the filters then an empty datasource will be returned
+// For more information about how we do our datasource filtering check out our guides: https://dell.github.io/terraform-docs/docs/storage/platforms/powerflex/product_guide/examples/ 
+data "powerflex_network" "network" {
+  # filter{
+  #   id = ["network_id"]
+  #   name = ["network_name"]
+  #   type = ["SCALEIO_DATA"]
+  #   vlan_id = [161]
+  # }
+}
+
+output "network_result" {
+  value = data.powerflex_network.example1.network_details
+}
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+# This feature is only supported for PowerFlex 4.5 and above.
+# The provider must be configured with the target system of the replication consistency group.
+
+# Get all the snapshots of the volumes of a replication consistency group on the target system
+data "powerflex_replication_consistency_group_snapshot" "all" {
+  replication_consistency_group_id = "rcg-target-id"
+}
+
+output "rcgSnapshots" {
+  value = data.powerflex_replication_consistency_group_snapshot.all
+}
+
+# Get the snapshots of a snapshot group created by the Snapshot action of the powerflex_replication_consistency_group_action resource
+data "powerflex_replication_consistency_group_snapshot" "snapshot_group" {
+  replication_consistency_group_id = "rcg-target-id"
+  snapshot_group_id                = "snapshot-group-id"
+}
+
+# Map the snapshots to a test SDC on the target system
+resource "powerflex_sdc_volumes_mapping" "dr_test" {
+  id = "sdc-id"
+  volume_list = [
+    for snapshot in data.powerflex_replication_consistency_group_snapshot.snapshot_group.snapshots : {
+      volume_id   = snapshot.id
+      access_mode = "ReadOnly"
+    }
+  ]
+}
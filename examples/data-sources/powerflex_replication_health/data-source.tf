@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+# This feature is only supported for PowerFlex 4.5 and above.
+
+# Empty filter block will return the health of all the replication consistency groups
+data "powerflex_replication_health" "all" {}
+
+output "replicationHealth" {
+  value = data.powerflex_replication_health.all
+}
+
+# Filter the replication consistency groups
+# The filter block supports the same fields as the powerflex_replication_consistency_group datasource
+data "powerflex_replication_health" "filtered" {
+  filter {
+    name = ["rcg-1", "rcg-2"]
+  }
+}
+
+# Fail the run when the RPO of a replication consistency group is breached
+check "replication_rpo" {
+  assert {
+    condition     = data.powerflex_replication_health.filtered.compliant
+    error_message = "Replication is not compliant: ${jsonencode({ for rcg in data.powerflex_replication_health.filtered.replication_health_details : rcg.name => rcg.non_compliant_reasons if !rcg.compliant })}"
+  }
+}
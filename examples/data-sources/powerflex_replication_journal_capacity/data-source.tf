@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# commands to run this tf file : terraform init && terraform apply --auto-approve
+
+# Get the recommended replication journal capacity of the storage pools of a protection domain
+# for a write bandwidth of 100 MB/s, an RPO of 60 seconds and an outage of 30 minutes
+data "powerflex_replication_journal_capacity" "journal" {
+  protection_domain_name           = "pd-1"
+  write_bandwidth_in_mb_per_second = 100
+  rpo_in_seconds                   = 60
+  outage_tolerance_in_minutes      = 30
+}
+
+output "journalCapacity" {
+  value = data.powerflex_replication_journal_capacity.journal
+}
+
+# Set the replication journal capacity of a storage pool to the recommended value
+# resource "powerflex_storage_pool" "pool" {
+#   name                         = "pool-1"
+#   protection_domain_name       = "pd-1"
+#   media_type                   = "HDD"
+#   replication_journal_capacity = [for pool in data.powerflex_replication_journal_capacity.journal.storage_pools : pool.recommended_percentage if pool.name == "pool-1"][0]
+# }
@@ -17,6 +17,8 @@ limitations under the License.
 
 # Command to run this tf file : terraform init && terraform plan && terraform apply.
 # Create, Read, Delete and Import operations are supported for this resource.
+# Update operation supports adding SDS and SDC nodes to and removing them from the cluster list. MDM and TieBreaker nodes cannot be added, modified or removed.
+# If the installation fails, the next apply resumes or rolls back the installer operation according to on_installation_failure.
 
 # Example for deploying cluster. After successful execution, 3 node MDM cluster will be deployed with 3 SDCs and 2 SDS.
 resource "powerflex_package" "upload-test" {
@@ -41,6 +43,9 @@ resource "powerflex_cluster" "test" {
   allow_non_secure_communication_with_mdm = false
   disable_non_mgmt_components_auth        = false
 
+  # Behavior of the next apply when the installer fails, resume or rollback
+  on_installation_failure = "resume"
+
   # Cluster Configuration related fields 
   cluster = [
     {
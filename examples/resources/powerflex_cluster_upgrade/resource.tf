@@ -0,0 +1,50 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# Delete only removes the resource from the state, the cluster is not downgraded
+# mdm_ip, mdm_password, lia_password and target_version are the required parameters
+# If an upgrade fails, apply again to resume it, the components which are already at the target version are skipped
+
+# Upload the packages of the target version to the gateway
+resource "powerflex_package" "upgrade-packages" {
+  file_path = ["/root/powerflex_packages/PowerFlex_4.5.2100.105_SLES15.4/EMC-ScaleIO-lia-4.5-2100.105.sles15.4.x86_64.rpm",
+    "/root/powerflex_packages/PowerFlex_4.5.2100.105_SLES15.4/EMC-ScaleIO-mdm-4.5-2100.105.sles15.4.x86_64.rpm",
+    "/root/powerflex_packages/PowerFlex_4.5.2100.105_SLES15.4/EMC-ScaleIO-sds-4.5-2100.105.sles15.4.x86_64.rpm",
+  "/root/powerflex_packages/PowerFlex_4.5.2100.105_SLES15.4/EMC-ScaleIO-sdc-4.5-2100.105.sles15.4.x86_64.rpm"]
+}
+
+# Upgrade the MDMs, then the SDSs one fault set at a time, then the SDCs
+resource "powerflex_cluster_upgrade" "example" {
+  depends_on = [powerflex_package.upgrade-packages]
+
+  mdm_ip         = "10.10.10.1"
+  mdm_password   = "Password"
+  lia_password   = "Password"
+  target_version = "4.5.2100.105"
+
+  allow_non_secure_communication_with_lia = false
+  allow_non_secure_communication_with_mdm = false
+
+  # maximum time to wait for rebuild and rebalance between the fault sets
+  rebuild_rebalance_timeout_in_minutes = 120
+}
+
+output "cluster_upgrade_components" {
+  value = powerflex_cluster_upgrade.example.components
+}
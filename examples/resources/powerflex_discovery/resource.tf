@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create and Delete is supported for this resource
+# Any change of ip_ranges, resource_type or credential_id runs a new discovery job
+# Delete removes the discovered devices from the inventory only if remove_devices_on_destroy is true
+# ip_ranges, resource_type and credential_id are the required parameters
+
+resource "powerflex_resource_credential" "node" {
+  name     = "node-credential"
+  type     = "Node"
+  username = "root"
+  password = "Password"
+}
+
+# Discover the nodes of the given IP range
+resource "powerflex_discovery" "nodes" {
+  ip_ranges = [
+    {
+      start_ip = "10.10.10.11"
+      end_ip   = "10.10.10.14"
+    }
+  ]
+  resource_type = "Node"
+  credential_id = powerflex_resource_credential.node.id
+  timeout       = 30
+}
+
+output "discovered_nodes" {
+  value = powerflex_discovery.nodes.ref_ids
+}
@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import, check import.sh for more info
+# license_file_path is the required parameter
+# Changing license_file_path installs the new license file on the system
+# A license cannot be uninstalled from the system, destroying this resource only removes it from the terraform state
+
+resource "powerflex_license" "example" {
+  license_file_path = "/path/to/license.lic"
+}
+
+output "license_details" {
+  value = powerflex_license.example
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import, check import.sh for more info
+# name, type and vlan_id are the required parameters
+# subnet is required when gateway, DNS, ip_ranges or static_routes are specified, otherwise the addresses are assigned by DHCP
+
+# PowerFlex data network with a pool of static addresses
+resource "powerflex_network" "data" {
+  name          = "PFlex-Data1"
+  description   = "PowerFlex data network"
+  type          = "SCALEIO_DATA"
+  vlan_id       = 161
+  subnet        = "255.255.255.0"
+  gateway       = "10.10.161.1"
+  primary_dns   = "10.10.10.10"
+  secondary_dns = "10.10.10.11"
+  dns_suffix    = "example.com"
+
+  ip_ranges = [
+    {
+      start_ip = "10.10.161.20"
+      end_ip   = "10.10.161.40"
+    }
+  ]
+}
+
+# PXE network whose addresses are assigned by DHCP
+resource "powerflex_network" "pxe" {
+  name    = "PFlex-PXE"
+  type    = "PXE"
+  vlan_id = 150
+}
+
+# The networks can be referenced by the templates
+resource "powerflex_template" "example" {
+  name        = "HCI-Template"
+  network_ids = [powerflex_network.data.id, powerflex_network.pxe.id]
+
+  components = [
+    {
+      name      = "Node"
+      type      = "SERVER"
+      instances = 3
+    }
+  ]
+}
@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# nodes is the required parameter
+# The inventory is refreshed again on the next apply once it is older than max_age, and Delete only removes the resource from the state
+
+# Refresh the inventory and health of the nodes before checking their health
+resource "powerflex_node_inventory_refresh" "example" {
+  nodes   = ["ABCD123", "10.10.10.2"]
+  max_age = 120
+  timeout = 45
+}
+
+output "node_health" {
+  value = { for node in powerflex_node_inventory_refresh.example.node_details : node.service_tag => node.health }
+}
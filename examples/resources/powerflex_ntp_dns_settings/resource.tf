@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# Delete only removes the resource from the state, the settings are not reverted
+# To import, check import.sh for more info
+# ntp_servers is the required parameter
+
+# Set NTP, DNS and timezone of PowerFlex Manager
+resource "powerflex_ntp_dns_settings" "example" {
+  ntp_servers = ["10.10.10.1", "10.10.10.2"]
+  dns_servers = ["10.10.10.3"]
+  timezone    = "UTC"
+}
+
+# Set NTP, DNS and timezone of PowerFlex Manager and of the cluster nodes
+# Nodes whose settings drift are listed in out_of_sync_nodes and are updated on the next apply
+resource "powerflex_ntp_dns_settings" "cluster" {
+  ntp_servers = ["10.10.10.1", "10.10.10.2"]
+  dns_servers = ["10.10.10.3"]
+  timezone    = "UTC"
+
+  cluster_nodes = {
+    mdm_ip       = "10.10.10.10"
+    mdm_password = "Password"
+    lia_password = "Password"
+    node_ips     = ["10.10.10.11", "10.10.10.12", "10.10.10.13"]
+  }
+}
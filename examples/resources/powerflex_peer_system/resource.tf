@@ -74,6 +74,15 @@ resource "powerflex_peer_system" "system_1" {
   # If true source_primary_mdm_information and destination_primary_mdm_information must be filled out in order to get and set the certificate
   #add_certificate = true
 
+  # Method used to exchange the certificate, Options (SSH, API, PEM) Default: SSH
+  # SSH requires the ssh and management details of both source_primary_mdm_information and destination_primary_mdm_information
+  # API only requires the management details of destination_primary_mdm_information, the root certificate is fetched through the REST API
+  # PEM adds the root certificate supplied in destination_root_certificate, no other details are required
+  #certificate_exchange_method = "SSH"
+  #destination_root_certificate = file("system_2_root.pem")
+  # Skip TLS verification of the destination management instance when using the API method, default: false
+  #management_insecure = false
+
   # source_primary_mdm_information = {
   #   # Required fields
   #   ip = "1.2.3.4"
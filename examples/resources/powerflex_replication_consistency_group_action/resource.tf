@@ -49,9 +49,27 @@ resource "powerflex_replication_consistency_group_action" "example" {
   id = data.powerflex_replication_consistency_group.rcg_filter.replication_consistency_group_details[0].id
 
   # Action to be performed on the replication consistency group.
-  # Options are Failover, Restore, Sync, Reverse, Switchover and Snapshot (Default is Sync)
+  # Options are Failover, Restore, Sync, Reverse, Switchover, Snapshot, TestFailover and TestFailoverStop (Default is Sync)
   action = var.action
 
+  # Optional
+
+  # Wait until the replication consistency group reaches the state expected after the action (Default is false)
+  # The final states of the replication consistency group like failover_state and disaster_recovery_state are available in the state
+  wait_for_completion = true
+
+  # Time in minutes to wait for the action to complete (Default is 10)
+  timeout = 10
+
+  # Connection details of the target system, used by the Snapshot action to read the IDs of the created snapshots
+  # snapshot_group_id and snapshot_ids (source volume ID to snapshot ID) are then available in the state
+  # target_system = {
+  #   endpoint = "https://10.10.10.10"
+  #   username = "user"
+  #   password = "password"
+  #   insecure = true
+  # }
+
   // This will allow terraform create process to trigger each time we run terraform apply.
   lifecycle {
     replace_triggered_by = [
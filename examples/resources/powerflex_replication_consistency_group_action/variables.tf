@@ -36,5 +36,5 @@ variable "replication_consistency_group_name" {
 
 variable "action" {
   type        = string
-  description = "The Replication Consistency Group action to be preformed. Options Failover, Restore, Sync, Reverse, Switchover and Snapshot"
+  description = "The Replication Consistency Group action to be preformed. Options Failover, Restore, Sync, Reverse, Switchover, Snapshot, TestFailover and TestFailoverStop"
 }
@@ -64,7 +64,7 @@ data "powerflex_replication_consistency_group" "source_replication_consistency_g
 
 # Commands to run this tf file : terraform init && terraform plan && terraform apply
 # Create, Update, Delete is supported for this resource
-# name, source_volume_id, destination_volume_id, replication_consistency_group_id are the required parameters to create or update
+# name, source_volume_id, replication_consistency_group_id and one of destination_volume_id or destination_volume are the required parameters to create or update
 # Only pause_initial_copy can be updated to true or false
 
 # Create Replication Pair
@@ -78,3 +78,25 @@ resource "powerflex_replication_pair" "example" {
   # Optional values
   # pause_initial_copy = true # Pauses the replication pair (This will only work during the initial copy process), defaults to false
 }
+
+# Create Replication Pair along with the destination volume on the peer system
+# The destination volume is created with the same size as the source volume using the credentials of the peer system
+resource "powerflex_replication_pair" "provisioned" {
+  provider = powerflex.source
+  # Required values
+  name                             = "example-provisioned-pair"
+  source_volume_id                 = data.powerflex_volume.source_volume.volumes[0].id
+  replication_consistency_group_id = data.powerflex_replication_consistency_group.source_replication_consistency_group.replication_consistency_group_details[0].id
+  destination_volume = {
+    endpoint               = var.endpoint_destination
+    username               = var.username_destination
+    password               = var.password_destination
+    insecure               = true
+    protection_domain_name = "domain1"
+    storage_pool_name      = "pool1"
+    # Optional values
+    # name_pattern      = "{source_volume_name}_replica" # Name of the destination volume, defaults to {source_volume_name}_replica
+    # volume_type       = "ThinProvisioned" # ThinProvisioned or ThickProvisioned, defaults to ThinProvisioned
+    # delete_on_destroy = false # Removes the destination volume from the peer system on destroy, defaults to false
+  }
+}
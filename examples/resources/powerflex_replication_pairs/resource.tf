@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Commands to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# replication_consistency_group_id, destination_volume and one of source_volume_ids or source_volume_name_regex are the required parameters
+# Replication pairs are created for the selected source volumes along with their destination volumes on the peer system
+# Replication pairs created by this resource are removed when their source volumes are no longer selected
+# This feature is only supported for PowerFlex 4.5 and above.
+
+# Used to get the id of the replication consistency group
+data "powerflex_replication_consistency_group" "rcg" {
+  filter {
+    name = ["example-rcg"]
+  }
+}
+
+# Replicate all the volumes whose name starts with app-
+resource "powerflex_replication_pairs" "example" {
+  replication_consistency_group_id = data.powerflex_replication_consistency_group.rcg.replication_consistency_group_details[0].id
+  source_volume_name_regex         = "^app-.*"
+
+  # Alternatively, select the source volumes by IDs
+  # source_volume_ids = ["edb2059700000001", "edb2059700000002"]
+
+  # Optional values
+  # pair_name_pattern = "{source_volume_name}_rp" # Name of the replication pairs, defaults to {source_volume_name}_rp
+  # paused_source_volume_ids = ["edb2059700000001"] # Pauses the initial copy of the replication pairs of these source volumes
+
+  destination_volume = {
+    endpoint               = var.endpoint_destination
+    username               = var.username_destination
+    password               = var.password_destination
+    insecure               = true
+    protection_domain_name = "domain1"
+    storage_pool_name      = "pool1"
+    # Optional values
+    # name_pattern      = "{source_volume_name}_replica" # Name of the destination volumes, defaults to {source_volume_name}_replica
+    # volume_type       = "ThinProvisioned" # ThinProvisioned or ThickProvisioned, defaults to ThinProvisioned
+    # delete_on_destroy = false # Removes the destination volumes from the peer system when the replication pairs are removed, defaults to false
+  }
+}
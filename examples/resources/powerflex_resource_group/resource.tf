@@ -51,3 +51,74 @@ resource "powerflex_resource_group" "Data" {
   clone_from_host        = "pfmc-k8s-20230809-160"
   deployment_timeout     = 60
 }
+
+
+# example 3: Remove a node and replace a failed node with a node discovered by powerflex_discovery
+# nodes must be reduced by the number of nodes in remove_nodes
+
+resource "powerflex_resource_group" "Data" {
+  deployment_name        = "Test-Create-U1"
+  deployment_description = "Test Service-U1"
+  template_id            = "453c41eb-d72a-4ed1-ad16-bacdffbdd766"
+  firmware_id            = "8aaaee208c8c467e018cd37813250614"
+  nodes                  = 2
+  remove_nodes           = ["ABCD123"]
+  replace_nodes = [
+    {
+      node   = "10.10.10.12"
+      ref_id = powerflex_discovery.nodes.ref_ids[0]
+    }
+  ]
+  deployment_timeout = 60
+}
+
+
+# example 4: Deploy a template with parameters overridden at deployment time
+# overrides are validated against the parameter definitions of the template during plan
+
+resource "powerflex_resource_group" "Data" {
+  deployment_name        = "Test-Create-U1"
+  deployment_description = "Test Service-U1"
+  template_id            = "453c41eb-d72a-4ed1-ad16-bacdffbdd766"
+  firmware_id            = "8aaaee208c8c467e018cd37813250614"
+  overrides = [
+    {
+      component = "Node"
+      parameter = "os_host_name"
+      value     = "pfmc-node-1"
+    },
+    {
+      component = "PowerFlex Cluster"
+      parameter = "storage_pool_name"
+      value     = "SP1"
+    }
+  ]
+}
+
+
+# example 5: Retry a failed deployment and tear down the nodes and VMs when the Resource Group is deleted
+# retry_on_failure retries a failed deployment with the retry API of PowerFlex Manager
+# reset_idrac and delete_vms tear down the nodes and VMs before the Resource Group is deleted
+
+resource "powerflex_resource_group" "Data" {
+  deployment_name        = "Test-Create-U1"
+  deployment_description = "Test Service-U1"
+  template_id            = "453c41eb-d72a-4ed1-ad16-bacdffbdd766"
+  firmware_id            = "8aaaee208c8c467e018cd37813250614"
+  retry_on_failure       = 2
+  servers_in_inventory   = "remove"
+  reset_idrac            = true
+  delete_vms             = true
+}
+
+
+# example 6: Adopt an existing cluster whose nodes have been discovered by powerflex_discovery
+# template_id must describe the existing cluster, the firmware of the adopted nodes is not updated
+
+resource "powerflex_resource_group" "Data" {
+  deployment_name        = "Existing-Cluster"
+  deployment_description = "Existing PowerFlex cluster"
+  template_id            = "453c41eb-d72a-4ed1-ad16-bacdffbdd766"
+  firmware_id            = "8aaaee208c8c467e018cd37813250614"
+  adopt_existing         = true
+}
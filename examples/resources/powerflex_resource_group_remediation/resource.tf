@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# resource_group_id is the required parameter
+# Nodes which are no longer compliant are reported during plan and updated again only when the inputs or triggers change, and Delete only removes the resource from the state
+
+# Remediate all the nodes of the resource group, one node at a time
+resource "powerflex_resource_group_remediation" "all" {
+  resource_group_id = "8aaa03a88de961fa018de96a88d80008"
+}
+
+# Remediate the selected nodes together and keep them in maintenance mode after the update
+resource "powerflex_resource_group_remediation" "selected" {
+  resource_group_id     = "8aaa03a88de961fa018de96a88d80009"
+  nodes                 = ["ABCD123", "10.10.10.2"]
+  rolling               = false
+  exit_maintenance_mode = false
+  timeout               = 240
+
+  # change the triggers to remediate the nodes again
+  triggers = {
+    catalog = "45.373.00"
+  }
+}
+
+output "compliance_summary" {
+  value = powerflex_resource_group_remediation.all.compliance_summary
+}
@@ -50,4 +50,9 @@ resource "powerflex_sdc_host" "sdc_linux" {
   # Optional all the mdms (either primary,secondary or virtual ips) in a comma separated list by cluster if unset will use the mdms of the cluster set in the provider block
   # Removal of mdms is not supported for linux, if you wish to remove a cluster from the sdc please follow steps here: https://www.dell.com/support/kbdoc/en-us/000167031/how-do-i-remove-the-mdm-entry-from-the-sdc-as-displayed-in-the-output-of-drv-cfg-binary-in-query-mdms-on-the-sdc-on-windows-or-linux-os#:~:text=Resolution%201%20For%20Linux%20SDC%20host%2C%20open%20%2Fbin%2Femc%2Fscaleio%2Fdrv_cfg.txt,4%20Reboot%20Linux%20SDC%20host%20to%20apply%20changes.?msockid=0ee30a4c8e9f67f610c21ecc8f89664a
   # clusters_mdm_ips = ["10.10.10.5,10.10.10.6", "10.10.10.7,10.10.10.8"] 
+  # Optional CHAP authentication of the SDC with the MDM, the CHAP secret is pushed to the drv_cfg of the host
+  # If chap_secret is unset, it is generated by PowerFlex
+  # SDC authentication should be enabled on the system using sdc_authentication_enabled of powerflex_system once all the SDCs are configured
+  # enable_authentication = true
+  # chap_secret           = "chap_secret"
 }
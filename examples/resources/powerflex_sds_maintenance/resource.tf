@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# sds_id is the required parameter
+# The SDS exits maintenance mode when enabled is set to false or when the resource is destroyed
+
+# Put the SDS into protected maintenance mode for hardware work on its node
+resource "powerflex_sds_maintenance" "example" {
+  sds_id           = "0db7306f00000003"
+  maintenance_type = "Protected"
+  timeout          = 120
+}
+
+# Keep the resource but take the SDS out of instant maintenance mode
+# resource "powerflex_sds_maintenance" "example2" {
+#   sds_id  = "0db7306f00000004"
+#   enabled = false
+# }
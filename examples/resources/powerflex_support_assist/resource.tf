@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import, check import.sh for more info
+# connection_type and contact are the required parameters
+# This feature is only supported for PowerFlex 4.5 and above.
+
+# Configure SupportAssist to connect through a Secure Connect Gateway
+resource "powerflex_support_assist" "example" {
+  connection_type = "Gateway"
+  gateway_host    = "10.10.10.10"
+  gateway_port    = 9443
+
+  contact = {
+    first_name = "first_name"
+    last_name  = "last_name"
+    email      = "user@example.com"
+    phone      = "1234567890"
+  }
+
+  # Optional proxy to reach the Dell backend
+  # proxy = {
+  #   host     = "10.10.10.11"
+  #   port     = 3128
+  #   username = "proxy_user"
+  #   password = "proxy_password"
+  # }
+
+  # Test the connectivity after the configuration is applied, defaults to true
+  test_connection = true
+}
+
+# Configure SupportAssist to connect directly to the Dell backend
+# resource "powerflex_support_assist" "direct" {
+#   connection_type = "Direct"
+#   access_key      = "access_key"
+#   pin             = "pin"
+#   contact = {
+#     first_name = "first_name"
+#     last_name  = "last_name"
+#     email      = "user@example.com"
+#   }
+# }
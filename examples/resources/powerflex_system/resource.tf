@@ -32,4 +32,14 @@ resource "powerflex_system" "test" {
       ips = ["sdc_ip3"]
     },
   ]
+
+  # cluster wide defaults, these are read back into the state to detect drift
+  capacity_alert_high_threshold     = 80
+  capacity_alert_critical_threshold = 90
+  default_performance_profile       = "HighPerformance"
+  sdc_reconnect_interval            = 30
+  allow_multiple_mappings           = true
+
+  # enable CHAP authentication of SDCs once the CHAP secrets are configured on all the SDCs
+  # sdc_authentication_enabled = true
 }
\ No newline at end of file
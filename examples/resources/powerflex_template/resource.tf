@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Command to run this tf file : terraform init && terraform plan && terraform apply
+# Create, Update, Delete is supported for this resource
+# To import, check import.sh for more info
+# name and components are the required parameters
+# Only the declared parameters of the components are managed, the other parameters keep the values set by PowerFlex Manager
+
+# Template with three hyperconverged nodes and a PowerFlex cluster, published so that it can be deployed
+resource "powerflex_template" "example" {
+  name                   = "HCI-Template"
+  description            = "Hyperconverged template"
+  category               = "Production"
+  firmware_repository_id = "8aaa80658cd602e0018cd996a1c91bdc"
+  network_ids            = ["8aaa80658cd602e0018cd996a1c91bdd", "8aaa80658cd602e0018cd996a1c91bde"]
+  published              = true
+
+  components = [
+    {
+      name      = "Node"
+      type      = "SERVER"
+      instances = 3
+      os_image  = "PowerFlex-OS"
+      parameters = {
+        scaleio_role = "hci"
+      }
+    },
+    {
+      name               = "PowerFlex Cluster"
+      type               = "SCALEIO"
+      related_components = ["Node"]
+      parameters = {
+        protection_domain = "PD1"
+      }
+    }
+  ]
+}
+
+# The published template can be deployed by the resource group
+resource "powerflex_resource_group" "example" {
+  deployment_name        = "HCI-Deployment"
+  deployment_description = "Hyperconverged deployment"
+  template_id            = powerflex_template.example.id
+  firmware_id            = powerflex_template.example.firmware_repository_id
+}
@@ -41,4 +41,10 @@ const (
 
 	// Snapshot is the snapshot constant
 	Snapshot = "Snapshot"
+
+	// TestFailover is the test failover constant
+	TestFailover = "TestFailover"
+
+	// TestFailoverStop is the test failover stop constant
+	TestFailoverStop = "TestFailoverStop"
 )
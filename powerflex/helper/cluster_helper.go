@@ -21,8 +21,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -1050,84 +1052,44 @@ func GetClusterDetails(model models.ClusterResourceModel, gatewayClient *goscale
 }
 
 // ClusterInstallationOperations function for begin instllation process
-func ClusterInstallationOperations(ctx context.Context, model models.ClusterResourceModel, gatewayClient *goscaleio.GatewayClient, parsecsvRespose *goscaleio_types.GatewayResponse) error {
-
-	beginInstallationResponse, installationError := gatewayClient.BeginInstallation(parsecsvRespose.Data, "admin", model.MdmPassword.ValueString(), model.LiaPassword.ValueString(), model.AllowNonSecureCommunicationWithMdm.ValueBool(), model.AllowNonSecureCommunicationWithLia.ValueBool(), model.DisableNonMgmtComponentsAuth.ValueBool(), false)
+func ClusterInstallationOperations(ctx context.Context, model models.ClusterResourceModel, gatewayClient *goscaleio.GatewayClient, parsecsvRespose *goscaleio_types.GatewayResponse) (*InstallerOperation, error) {
+	return runClusterInstallation(ctx, model, gatewayClient, parsecsvRespose, false)
+}
 
-	if installationError != nil {
-		return fmt.Errorf("Error while begin installation is %s", installationError.Error())
+// ClusterExpansionOperations function for extending the installed cluster with the new nodes of the cluster list
+func ClusterExpansionOperations(ctx context.Context, model models.ClusterResourceModel, gatewayClient *goscaleio.GatewayClient, clusterDataModel []models.ClusterModel, storagePoolDataModel []models.StoragePoolDataModel) (*InstallerOperation, error) {
+	// to make gateway available for installation
+	queueOperationError := ResetInstallerQueue(gatewayClient)
+	if queueOperationError != nil {
+		return nil, fmt.Errorf("Error Clearing Queue Before Expansion is %s", queueOperationError.Error())
 	}
 
-	if beginInstallationResponse.StatusCode == 200 {
-		currentPhase := "query"
-		couterForStopExecution := 0
-
-		tflog.Info(ctx, "Gateway Installation Begin, Current Phase - Query")
-
-		for couterForStopExecution <= 5 {
-
-			time.Sleep(1 * time.Minute)
-
-			checkForPhaseCompleted, _ := gatewayClient.CheckForCompletionQueueCommands(currentPhase)
-
-			if checkForPhaseCompleted.Data == "Completed" {
-				couterForStopExecution = 0
-
-				if currentPhase != "configure" {
-					moveToNextPhaseResponse, err := gatewayClient.MoveToNextPhase()
-
-					if err != nil {
-						return fmt.Errorf("Error while moving to next phase is %s", err.Error())
-					}
-
-					if moveToNextPhaseResponse.StatusCode == 200 {
-						if currentPhase == "query" {
-							currentPhase = "upload"
-							tflog.Info(ctx, "Gateway Installation phase changed to Upload")
-						} else if currentPhase == "upload" {
-							currentPhase = "install"
-							tflog.Info(ctx, "Gateway Installation phase changed to Install")
-						} else if currentPhase == "install" {
-							currentPhase = "configure"
-							tflog.Info(ctx, "Gateway Installation phase changed to Configure")
-						}
-					} else {
-						return fmt.Errorf("Messsage: %s, Error Code: %s", moveToNextPhaseResponse.Message, strconv.Itoa(moveToNextPhaseResponse.StatusCode))
-					}
-				} else {
-					// to make gateway available for installation
-					queueOperationError := ResetInstallerQueue(gatewayClient)
-					if queueOperationError != nil {
-						return fmt.Errorf("Error Clearing Queue During Installation is %s", queueOperationError.Error())
-					}
+	parsecsvRespose, parseCSVError := ParseClusterCSVOperation(ctx, gatewayClient, clusterDataModel, storagePoolDataModel)
+	if parseCSVError != nil {
+		return nil, fmt.Errorf("Error while Parsing CSV is %s", parseCSVError.Error())
+	}
 
-					return nil
-				}
+	tflog.Info(ctx, "Gateway Installer CSV Parsed successfully for expansion")
 
-			} else if checkForPhaseCompleted.Data == "Running" {
-				couterForStopExecution++
+	return runClusterInstallation(ctx, model, gatewayClient, parsecsvRespose, true)
+}
 
-				tflog.Info(ctx, "Gateway Installation operations are still running in phase "+currentPhase)
+// runClusterInstallation begins the gateway installer, extending the installed cluster if expansion is true, and runs its phases
+func runClusterInstallation(ctx context.Context, model models.ClusterResourceModel, gatewayClient *goscaleio.GatewayClient, parsecsvRespose *goscaleio_types.GatewayResponse, expansion bool) (*InstallerOperation, error) {
 
-				if couterForStopExecution == 6 {
-					// to make gateway available for installation
-					queueOperationError := ResetInstallerQueue(gatewayClient)
-					if queueOperationError != nil {
-						return fmt.Errorf("Error Clearing Queue During Installation in phase %s is %s", currentPhase, queueOperationError.Error())
-					}
+	beginInstallationResponse, installationError := gatewayClient.BeginInstallation(parsecsvRespose.Data, "admin", model.MdmPassword.ValueString(), model.LiaPassword.ValueString(), model.AllowNonSecureCommunicationWithMdm.ValueBool(), model.AllowNonSecureCommunicationWithLia.ValueBool(), model.DisableNonMgmtComponentsAuth.ValueBool(), expansion)
 
-					return fmt.Errorf("Time Out,Some Operations of Installer running from since long")
-				}
+	if installationError != nil {
+		return nil, fmt.Errorf("Error while begin installation is %s", installationError.Error())
+	}
 
-			} else {
-				return fmt.Errorf("Error During Installation is %s", checkForPhaseCompleted.Message)
-			}
-		}
-	} else {
-		return fmt.Errorf("Message: %s, Error Code: %s", beginInstallationResponse.Message, strconv.Itoa(beginInstallationResponse.StatusCode))
+	if beginInstallationResponse.StatusCode != 200 {
+		return nil, fmt.Errorf("Message: %s, Error Code: %s", beginInstallationResponse.Message, strconv.Itoa(beginInstallationResponse.StatusCode))
 	}
 
-	return nil
+	tflog.Info(ctx, "Gateway Installation Begin, Current Phase - Query")
+
+	return runInstallerPhases(ctx, gatewayClient, 0)
 }
 
 // ClusterUninstallationOperations function for begin uninstllation process
@@ -1240,6 +1202,227 @@ func ClusterUninstallationOperations(ctx context.Context, model models.ClusterRe
 	return nil
 }
 
+// GetClusterNodeKey returns the IP identifying a node of the cluster list
+func GetClusterNodeKey(node models.ClusterModel) string {
+	for _, ip := range []types.String{node.IP, node.MDMMgmtIP, node.SDSAllIPs, node.SDRAllIPS, node.SDTAllIPs} {
+		if ip.ValueString() != "" {
+			return strings.Split(ip.ValueString(), ",")[0]
+		}
+	}
+	return ""
+}
+
+// isClusterMdmNode returns true if the node is a member of the MDM cluster
+func isClusterMdmNode(node models.ClusterModel) bool {
+	role := strings.TrimSpace(node.IsMdmOrTb.ValueString())
+	return role != "" && !strings.EqualFold(role, "No")
+}
+
+// GetClusterUnsupportedChanges returns the attributes other than the cluster list which are changed in the plan.
+// Attributes which are not set in the state, as after an import, are not compared.
+func GetClusterUnsupportedChanges(plan, state models.ClusterResourceModel) []string {
+	changed := []string{}
+	for name, values := range map[string][2]attr.Value{
+		"storage_pools": {state.StoragePools, plan.StoragePools},
+		"mdm_password":  {state.MdmPassword, plan.MdmPassword},
+		"lia_password":  {state.LiaPassword, plan.LiaPassword},
+		"allow_non_secure_communication_with_mdm": {state.AllowNonSecureCommunicationWithMdm, plan.AllowNonSecureCommunicationWithMdm},
+		"allow_non_secure_communication_with_lia": {state.AllowNonSecureCommunicationWithLia, plan.AllowNonSecureCommunicationWithLia},
+		"disable_non_mgmt_components_auth":        {state.DisableNonMgmtComponentsAuth, plan.DisableNonMgmtComponentsAuth},
+	} {
+		if !values[0].IsNull() && !values[0].Equal(values[1]) {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// GetClusterNodeChanges compares the cluster list of the state and of the plan and returns the nodes to add and to remove.
+// Only SDS and SDC nodes can be added or removed, the existing nodes cannot be modified.
+func GetClusterNodeChanges(stateNodes, planNodes []models.ClusterModel) ([]models.ClusterModel, []models.ClusterModel, error) {
+	stateByKey := make(map[string]models.ClusterModel)
+	for _, node := range stateNodes {
+		stateByKey[GetClusterNodeKey(node)] = node
+	}
+	planKeys := make(map[string]bool)
+
+	added := []models.ClusterModel{}
+	for _, node := range planNodes {
+		key := GetClusterNodeKey(node)
+		planKeys[key] = true
+		existing, ok := stateByKey[key]
+		if !ok {
+			if isClusterMdmNode(node) {
+				return nil, nil, fmt.Errorf("node %s cannot be added, adding MDM or TieBreaker nodes is not supported", key)
+			}
+			added = append(added, node)
+			continue
+		}
+		if existing != node {
+			return nil, nil, fmt.Errorf("node %s cannot be modified, only SDS and SDC nodes can be added to or removed from the cluster", key)
+		}
+	}
+
+	removed := []models.ClusterModel{}
+	for _, node := range stateNodes {
+		key := GetClusterNodeKey(node)
+		if planKeys[key] {
+			continue
+		}
+		if isClusterMdmNode(node) {
+			return nil, nil, fmt.Errorf("node %s cannot be removed, removing MDM or TieBreaker nodes is not supported", key)
+		}
+		removed = append(removed, node)
+	}
+	return added, removed, nil
+}
+
+// ClusterNodeRemovalPhases are the phases of the gateway installer uninstall flow which remove the nodes from the cluster
+var ClusterNodeRemovalPhases = []string{"query", "clean"}
+
+// ClusterNodeRemovalPollInterval is the interval at which the phases of the node removal are polled
+var ClusterNodeRemovalPollInterval = 30 * time.Second
+
+// GetClusterNodeRemovalTopology restricts the topology of the cluster to the SDS and SDC components of the removed nodes,
+// so that the uninstall flow of the gateway installer only removes them. It returns nil if none of them is left in the cluster.
+func GetClusterNodeRemovalTopology(topology string, nodes []models.ClusterModel) (map[string]interface{}, error) {
+	topologyData, err := jsonToMap(topology)
+	if err != nil {
+		return nil, fmt.Errorf("Error while reading the cluster topology: %s", err.Error())
+	}
+
+	sdsIPs, sdcIPs := map[string]bool{}, map[string]bool{}
+	for _, node := range nodes {
+		if strings.EqualFold(node.IsSds.ValueString(), "Yes") {
+			sdsIPs[GetClusterNodeKey(node)] = true
+		}
+		if strings.EqualFold(node.IsSdc.ValueString(), "Yes") {
+			sdcIPs[GetClusterNodeKey(node)] = true
+		}
+	}
+	removed := func(key string, ips map[string]bool) []interface{} {
+		components := []interface{}{}
+		list, _ := topologyData[key].([]interface{})
+		for _, component := range list {
+			componentData, _ := component.(map[string]interface{})
+			node, _ := componentData["node"].(map[string]interface{})
+			nodeIPs, _ := node["nodeIPs"].([]interface{})
+			for _, ip := range nodeIPs {
+				if ips[fmt.Sprint(ip)] {
+					components = append(components, component)
+					break
+				}
+			}
+		}
+		return components
+	}
+	sdsList, sdcList := removed("sdsList", sdsIPs), removed("sdcList", sdcIPs)
+	if len(sdsList) == 0 && len(sdcList) == 0 {
+		return nil, nil
+	}
+
+	// the MDMs and the other components of the cluster are not part of the removal
+	for _, key := range []string{"masterMdm", "slaveMdmSet", "tbSet", "standbyMdmSet", "standbyTbSet"} {
+		delete(topologyData, key)
+	}
+	for _, key := range []string{"sdrList", "sdtList", "vasaProviderList", "protectionDomains"} {
+		topologyData[key] = []interface{}{}
+	}
+	topologyData["sdsList"] = sdsList
+	topologyData["sdcList"] = sdcList
+	return topologyData, nil
+}
+
+// RemoveClusterNodes removes the SDS and SDC of the nodes removed from the cluster list through the uninstall phases of the gateway installer.
+// The removed SDSs migrate their data to the other SDSs of the protection domain, which is awaited until the timeout.
+func RemoveClusterNodes(ctx context.Context, gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, model models.ClusterResourceModel, mdmIP string, nodes []models.ClusterModel, timeout time.Duration) error {
+	if client == nil {
+		return fmt.Errorf("PowerFlex client is not initialized")
+	}
+	deadline := time.Now().Add(timeout)
+
+	// to make gateway available for the removal
+	if err := ResetInstallerQueue(gatewayClient); err != nil {
+		return fmt.Errorf("Error Clearing Queue Before Node Removal is %s", err.Error())
+	}
+
+	clusterDetails, err := GetClusterDetails(model, gatewayClient, mdmIP, true)
+	if err != nil {
+		return fmt.Errorf("Error while getting the cluster topology is %s", err.Error())
+	}
+	topology, err := GetClusterNodeRemovalTopology(clusterDetails.Data, nodes)
+	if err != nil {
+		return err
+	}
+	// the nodes were already removed, e.g. by a previous apply which timed out
+	if topology == nil {
+		tflog.Info(ctx, "Removed nodes are no longer part of the cluster")
+		return nil
+	}
+
+	topologyJSON, err := json.Marshal(topology)
+	if err != nil {
+		return fmt.Errorf("Error while begin node removal is %s", err.Error())
+	}
+	response, err := gatewayClient.UninstallCluster(string(topologyJSON), "admin", model.MdmPassword.ValueString(), model.LiaPassword.ValueString(), model.AllowNonSecureCommunicationWithMdm.ValueBool(), model.AllowNonSecureCommunicationWithLia.ValueBool(), model.DisableNonMgmtComponentsAuth.ValueBool(), false)
+	if err != nil {
+		return fmt.Errorf("Error while begin node removal is %s", err.Error())
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("Message: %s, Error Code: %d", response.Message, response.StatusCode)
+	}
+
+	for i, phase := range ClusterNodeRemovalPhases {
+		tflog.Info(ctx, "Gateway node removal phase started", map[string]interface{}{"phase": phase})
+		if err := waitForClusterNodeRemovalPhase(ctx, gatewayClient, phase, deadline); err != nil {
+			return err
+		}
+		if i == len(ClusterNodeRemovalPhases)-1 {
+			break
+		}
+		response, err := gatewayClient.MoveToNextPhase()
+		if err != nil {
+			return fmt.Errorf("Error while moving to next phase is %s", err.Error())
+		}
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("Messsage: %s, Error Code: %d", response.Message, response.StatusCode)
+		}
+	}
+
+	// to make gateway available for installation
+	if err := ResetInstallerQueue(gatewayClient); err != nil {
+		return fmt.Errorf("Error Clearing Queue After Node Removal is %s", err.Error())
+	}
+
+	// the data of the removed SDSs is migrated to the other SDSs
+	if err := WaitForRebuildRebalance(ctx, client, time.Until(deadline)); err != nil {
+		return fmt.Errorf("nodes are removed but %s", err.Error())
+	}
+	return nil
+}
+
+// waitForClusterNodeRemovalPhase waits until the commands of the phase are completed or the deadline is reached
+func waitForClusterNodeRemovalPhase(ctx context.Context, gatewayClient *goscaleio.GatewayClient, phase string, deadline time.Time) error {
+	for time.Now().Before(deadline) {
+		time.Sleep(ClusterNodeRemovalPollInterval)
+
+		response, err := gatewayClient.CheckForCompletionQueueCommands(phase)
+		if err != nil {
+			return fmt.Errorf("Error while checking the %s phase is %s", phase, err.Error())
+		}
+		switch response.Data {
+		case "Completed":
+			tflog.Info(ctx, "Gateway node removal phase completed", map[string]interface{}{"phase": phase})
+			return nil
+		case "Failed":
+			return fmt.Errorf("Error in the %s phase of the node removal: %s", phase, response.Message)
+		}
+		tflog.Info(ctx, "Gateway node removal operations are still running", map[string]interface{}{"phase": phase})
+	}
+	return fmt.Errorf("timed out waiting for the %s phase of the node removal", phase)
+}
+
 // removeDuplicates Helper function to remove duplicates from a slice of strings
 func removeDuplicates(s []string) []string {
 	unique := make(map[string]bool)
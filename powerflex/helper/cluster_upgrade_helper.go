@@ -0,0 +1,453 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	goscaleio_types "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// ClusterUpgradeURI is the gateway URI to begin the upgrade of the cluster
+	ClusterUpgradeURI = "/im/types/Configuration/actions/upgrade"
+	// ClusterUpgradeStepCompleted is the status of an upgrade step which has been run
+	ClusterUpgradeStepCompleted = "Completed"
+	// ClusterUpgradeStepSkipped is the status of an upgrade step whose components are already at the target version
+	ClusterUpgradeStepSkipped = "Skipped"
+)
+
+// ClusterUpgradePhases are the phases of the gateway installer for an upgrade, in order
+var ClusterUpgradePhases = []string{"query", "upload", "upgrade", "clean"}
+
+// ClusterUpgradePollInterval is the interval between two checks of the upgrade progress
+var ClusterUpgradePollInterval = 30 * time.Second
+
+// ClusterUpgradePhaseTimeout is the maximum duration of a phase of the gateway installer
+var ClusterUpgradePhaseTimeout = 2 * time.Hour
+
+// ClusterComponentVersion defines the version of a component of the cluster
+type ClusterComponentVersion struct {
+	Type       string
+	ID         string
+	Name       string
+	IP         string
+	FaultSetID string
+	Version    string
+}
+
+// ClusterUpgradeStep defines a step of the rolling upgrade
+type ClusterUpgradeStep struct {
+	Name       string
+	Type       string
+	FaultSetID string
+	Components []ClusterComponentVersion
+}
+
+// sdcVersionInfo defines the version details of an SDC, which are not part of the goscaleio SDC type
+type sdcVersionInfo struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	SdcIP               string `json:"sdcIp"`
+	SoftwareVersionInfo string `json:"softwareVersionInfo"`
+}
+
+// normalizeClusterVersion converts versions like R4_5.2100.0 or 4.5-2100 to 4.5.2100.0 and 4.5.2100
+func normalizeClusterVersion(version string) string {
+	version = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(version)), "r")
+	return strings.NewReplacer("_", ".", "-", ".").Replace(version)
+}
+
+// CompareClusterVersions compares the components of the version with the components of the target version.
+// Only as many components as the target version has are compared, so that 4.5.2100.105 is equal to the target 4.5.2100.
+// It returns -1, 0 or 1 if the version is older than, equal to or newer than the target version.
+func CompareClusterVersions(version, target string) int {
+	versionParts := strings.Split(normalizeClusterVersion(version), ".")
+	targetParts := strings.Split(normalizeClusterVersion(target), ".")
+	for i, targetPart := range targetParts {
+		if i >= len(versionParts) {
+			return -1
+		}
+		versionNumber, versionErr := strconv.Atoi(versionParts[i])
+		targetNumber, targetErr := strconv.Atoi(targetPart)
+		if versionErr == nil && targetErr == nil {
+			if versionNumber != targetNumber {
+				if versionNumber < targetNumber {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+		if c := strings.Compare(versionParts[i], targetPart); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// ClusterVersionMatches returns true if the components of the version match all the components of the target version
+func ClusterVersionMatches(version, target string) bool {
+	return version != "" && CompareClusterVersions(version, target) == 0
+}
+
+// GetClusterComponentsNewerThan returns the names of the components whose version is newer than the target version
+func GetClusterComponentsNewerThan(components []ClusterComponentVersion, target string) []string {
+	newer := []string{}
+	for _, component := range components {
+		if component.Version != "" && CompareClusterVersions(component.Version, target) > 0 {
+			newer = append(newer, fmt.Sprintf("%s %s (%s)", component.Type, component.Name, component.Version))
+		}
+	}
+	return newer
+}
+
+// ValidateUpgradePackages checks that packages of the target version are uploaded to the gateway
+func ValidateUpgradePackages(gatewayClient *goscaleio.GatewayClient, target string) error {
+	packages, err := gatewayClient.GetPackageDetails()
+	if err != nil {
+		return fmt.Errorf("Error in getting the packages of the gateway: %s", err.Error())
+	}
+	for _, pkg := range packages {
+		if ClusterVersionMatches(pkg.Version, target) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no package of version %s is uploaded to the gateway, please upload the packages using powerflex_package resource", target)
+}
+
+// GetClusterComponentVersions returns the system version and the versions of the MDMs, SDSs and SDCs of the cluster
+func GetClusterComponentVersions(client *goscaleio.Client) (string, []ClusterComponentVersion, error) {
+	system, err := GetFirstSystem(client)
+	if err != nil {
+		return "", nil, err
+	}
+
+	mdmCluster, err := system.GetMDMClusterDetails()
+	if err != nil {
+		return "", nil, fmt.Errorf("Error in getting MDM cluster details: %s", err.Error())
+	}
+	components := []ClusterComponentVersion{}
+	mdms := append([]goscaleio_types.Mdm{mdmCluster.PrimaryMDM}, mdmCluster.SecondaryMDM...)
+	mdms = append(mdms, mdmCluster.TiebreakerMdm...)
+	mdms = append(mdms, mdmCluster.StandByMdm...)
+	for _, mdm := range mdms {
+		components = append(components, ClusterComponentVersion{
+			Type:    "MDM",
+			ID:      mdm.ID,
+			Name:    mdm.Name,
+			IP:      firstOrEmpty(mdm.IPs),
+			Version: mdm.VersionInfo,
+		})
+	}
+
+	sdss, err := system.GetAllSds()
+	if err != nil {
+		return "", nil, fmt.Errorf("Error in getting SDS list: %s", err.Error())
+	}
+	for _, sds := range sdss {
+		ip := ""
+		if len(sds.IPList) > 0 {
+			ip = sds.IPList[0].IP
+		}
+		components = append(components, ClusterComponentVersion{
+			Type:       "SDS",
+			ID:         sds.ID,
+			Name:       sds.Name,
+			IP:         ip,
+			FaultSetID: sds.FaultSetID,
+			Version:    sds.SoftwareVersionInfo,
+		})
+	}
+
+	var sdcs []sdcVersionInfo
+	if err := DoRestRequest(client, http.MethodGet, "/api/types/Sdc/instances", nil, &sdcs); err != nil {
+		return "", nil, fmt.Errorf("Error in getting SDC list: %s", err.Error())
+	}
+	for _, sdc := range sdcs {
+		components = append(components, ClusterComponentVersion{
+			Type:    "SDC",
+			ID:      sdc.ID,
+			Name:    sdc.Name,
+			IP:      sdc.SdcIP,
+			Version: sdc.SoftwareVersionInfo,
+		})
+	}
+
+	return system.System.SystemVersionName, components, nil
+}
+
+func firstOrEmpty(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// GetClusterUpgradeSteps orders the components in the steps of the rolling upgrade.
+// MDMs are upgraded first, then the SDSs one fault set at a time, then the SDCs.
+func GetClusterUpgradeSteps(components []ClusterComponentVersion) []ClusterUpgradeStep {
+	mdmStep := ClusterUpgradeStep{Name: "MDM", Type: "MDM"}
+	sdcStep := ClusterUpgradeStep{Name: "SDC", Type: "SDC"}
+	sdsSteps := map[string]*ClusterUpgradeStep{}
+	for _, component := range components {
+		switch component.Type {
+		case "MDM":
+			mdmStep.Components = append(mdmStep.Components, component)
+		case "SDC":
+			sdcStep.Components = append(sdcStep.Components, component)
+		case "SDS":
+			step, ok := sdsSteps[component.FaultSetID]
+			if !ok {
+				name := "SDS"
+				if component.FaultSetID != "" {
+					name = "SDS (fault set " + component.FaultSetID + ")"
+				}
+				step = &ClusterUpgradeStep{Name: name, Type: "SDS", FaultSetID: component.FaultSetID}
+				sdsSteps[component.FaultSetID] = step
+			}
+			step.Components = append(step.Components, component)
+		}
+	}
+
+	faultSets := make([]string, 0, len(sdsSteps))
+	for faultSet := range sdsSteps {
+		faultSets = append(faultSets, faultSet)
+	}
+	sort.Strings(faultSets)
+
+	steps := []ClusterUpgradeStep{mdmStep}
+	for _, faultSet := range faultSets {
+		steps = append(steps, *sdsSteps[faultSet])
+	}
+	return append(steps, sdcStep)
+}
+
+// IsClusterUpgradeStepPending returns true if a component of the step is not at the target version
+func IsClusterUpgradeStepPending(step ClusterUpgradeStep, target string) bool {
+	for _, component := range step.Components {
+		if !ClusterVersionMatches(component.Version, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetClusterUpgradeTopology restricts the topology of the cluster to the components of the step
+func GetClusterUpgradeTopology(topology string, step ClusterUpgradeStep) (map[string]interface{}, error) {
+	topologyData, err := jsonToMap(topology)
+	if err != nil {
+		return nil, fmt.Errorf("Error while reading the cluster topology: %s", err.Error())
+	}
+
+	for _, key := range []string{"sdrList", "sdtList", "vasaProviderList"} {
+		topologyData[key] = []interface{}{}
+	}
+	switch step.Type {
+	case "MDM":
+		topologyData["sdsList"] = []interface{}{}
+		topologyData["sdcList"] = []interface{}{}
+	case "SDS":
+		ids := map[string]bool{}
+		for _, component := range step.Components {
+			ids[component.ID] = true
+		}
+		sdsList := []interface{}{}
+		if list, ok := topologyData["sdsList"].([]interface{}); ok {
+			for _, sds := range list {
+				if sdsData, ok := sds.(map[string]interface{}); ok && ids[fmt.Sprint(sdsData["id"])] {
+					sdsList = append(sdsList, sds)
+				}
+			}
+		}
+		topologyData["sdsList"] = sdsList
+		topologyData["sdcList"] = []interface{}{}
+	case "SDC":
+		topologyData["sdsList"] = []interface{}{}
+	}
+	return topologyData, nil
+}
+
+// RunClusterUpgradeStep begins the upgrade of the components of the topology and moves the gateway installer through the upgrade phases
+func RunClusterUpgradeStep(ctx context.Context, gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, model models.ClusterUpgradeResourceModel, topology map[string]interface{}) error {
+	// to make gateway available for the upgrade
+	if err := ResetInstallerQueue(gatewayClient); err != nil {
+		return fmt.Errorf("Error Clearing Queue Before Upgrade is %s", err.Error())
+	}
+
+	topology["mdmUser"] = "admin"
+	topology["mdmPassword"] = model.MdmPassword.ValueString()
+	topology["liaPassword"] = model.LiaPassword.ValueString()
+	topology["securityConfiguration"] = map[string]interface{}{
+		"allowNonSecureCommunicationWithMdm": model.AllowNonSecureCommunicationWithMdm.ValueBool(),
+		"allowNonSecureCommunicationWithLia": model.AllowNonSecureCommunicationWithLia.ValueBool(),
+		"disableNonMgmtComponentsAuth":       model.DisableNonMgmtComponentsAuth.ValueBool(),
+	}
+
+	if err := DoGatewayRequest(gatewayClient, client, http.MethodPost, ClusterUpgradeURI, topology, nil); err != nil {
+		return fmt.Errorf("Error while begin upgrade is %s", err.Error())
+	}
+
+	for i, phase := range ClusterUpgradePhases {
+		tflog.Info(ctx, "Gateway upgrade phase started", map[string]interface{}{"phase": phase})
+		if err := waitForClusterUpgradePhase(ctx, gatewayClient, phase); err != nil {
+			return err
+		}
+		if i == len(ClusterUpgradePhases)-1 {
+			break
+		}
+		response, err := gatewayClient.MoveToNextPhase()
+		if err != nil {
+			return fmt.Errorf("Error while moving to next phase is %s", err.Error())
+		}
+		if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("Messsage: %s, Error Code: %d", response.Message, response.StatusCode)
+		}
+	}
+
+	// to make gateway available for the next step
+	if err := ResetInstallerQueue(gatewayClient); err != nil {
+		return fmt.Errorf("Error Clearing Queue After Upgrade is %s", err.Error())
+	}
+	return nil
+}
+
+// waitForClusterUpgradePhase waits until the commands of the phase are completed
+func waitForClusterUpgradePhase(ctx context.Context, gatewayClient *goscaleio.GatewayClient, phase string) error {
+	deadline := time.Now().Add(ClusterUpgradePhaseTimeout)
+	for time.Now().Before(deadline) {
+		time.Sleep(ClusterUpgradePollInterval)
+
+		response, err := gatewayClient.CheckForCompletionQueueCommands(phase)
+		if err != nil {
+			return fmt.Errorf("Error while checking the %s phase is %s", phase, err.Error())
+		}
+		switch response.Data {
+		case "Completed":
+			tflog.Info(ctx, "Gateway upgrade phase completed", map[string]interface{}{"phase": phase})
+			return nil
+		case "Failed":
+			return fmt.Errorf("Error in the %s phase of the upgrade: %s", phase, response.Message)
+		}
+		tflog.Info(ctx, "Gateway upgrade operations are still running", map[string]interface{}{"phase": phase})
+	}
+	return fmt.Errorf("timed out waiting for the %s phase of the upgrade", phase)
+}
+
+// WaitForRebuildRebalance waits until there is no rebuild, rebalance or data movement in progress in the system
+func WaitForRebuildRebalance(ctx context.Context, client *goscaleio.Client, timeout time.Duration) error {
+	system, err := GetFirstSystem(client)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		stats, err := system.GetStatistics()
+		if err != nil {
+			return fmt.Errorf("Error in getting system statistics: %s", err.Error())
+		}
+		pending := stats.ActiveFwdRebuildCapacityInKb + stats.ActiveBckRebuildCapacityInKb +
+			stats.PendingFwdRebuildCapacityInKb + stats.PendingBckRebuildCapacityInKb +
+			stats.ActiveRebalanceCapacityInKb + stats.PendingRebalanceCapacityInKb +
+			stats.ActiveMovingCapacityInKb + stats.PendingMovingCapacityInKb
+		if pending == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for rebuild and rebalance to complete, %d KB are still pending", pending)
+		}
+		tflog.Info(ctx, "Waiting for rebuild and rebalance to complete", map[string]interface{}{"pendingCapacityInKb": pending})
+		time.Sleep(ClusterUpgradePollInterval)
+	}
+}
+
+// UpdateClusterUpgradeState sets the versions and the steps of the upgrade in the state
+func UpdateClusterUpgradeState(plan models.ClusterUpgradeResourceModel, systemVersion string, components []ClusterComponentVersion, steps []models.ClusterUpgradeStepModel) (models.ClusterUpgradeResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	state.ID = types.StringValue(plan.MdmIP.ValueString())
+	state.SystemVersion = types.StringValue(systemVersion)
+
+	componentModels := make([]models.ClusterUpgradeComponentModel, 0, len(components))
+	for _, component := range components {
+		componentModels = append(componentModels, models.ClusterUpgradeComponentModel{
+			Type:       types.StringValue(component.Type),
+			ID:         types.StringValue(component.ID),
+			Name:       types.StringValue(component.Name),
+			IP:         types.StringValue(component.IP),
+			FaultSetID: types.StringValue(component.FaultSetID),
+			Version:    types.StringValue(component.Version),
+		})
+	}
+	componentList, dgs := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ClusterUpgradeComponentAttrTypes}, componentModels)
+	diags.Append(dgs...)
+	state.Components = componentList
+
+	if steps != nil {
+		stepList, dgs := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ClusterUpgradeStepAttrTypes}, steps)
+		diags.Append(dgs...)
+		state.Steps = stepList
+	}
+	return state, diags
+}
+
+// GetClusterUpgradeComponents returns the components of the cluster recorded in the state
+func GetClusterUpgradeComponents(ctx context.Context, list types.List) ([]ClusterComponentVersion, diag.Diagnostics) {
+	componentModels := []models.ClusterUpgradeComponentModel{}
+	diags := list.ElementsAs(ctx, &componentModels, true)
+	components := make([]ClusterComponentVersion, 0, len(componentModels))
+	for _, component := range componentModels {
+		components = append(components, ClusterComponentVersion{
+			Type:       component.Type.ValueString(),
+			ID:         component.ID.ValueString(),
+			Name:       component.Name.ValueString(),
+			IP:         component.IP.ValueString(),
+			FaultSetID: component.FaultSetID.ValueString(),
+			Version:    component.Version.ValueString(),
+		})
+	}
+	return components, diags
+}
+
+// ClusterUpgradeStepAttrTypes defines the attribute types of a step of the cluster upgrade
+var ClusterUpgradeStepAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"status": types.StringType,
+}
+
+// ClusterUpgradeComponentAttrTypes defines the attribute types of a component of the cluster upgrade
+var ClusterUpgradeComponentAttrTypes = map[string]attr.Type{
+	"type":         types.StringType,
+	"id":           types.StringType,
+	"name":         types.StringType,
+	"ip":           types.StringType,
+	"fault_set_id": types.StringType,
+	"version":      types.StringType,
+}
@@ -0,0 +1,260 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DiscoveryRequestURI is the PowerFlex Manager URI of the discovery requests
+	DiscoveryRequestURI = "/Api/V1/DiscoveryRequest"
+	// ManagedDeviceURI is the PowerFlex Manager URI of the devices of the inventory
+	ManagedDeviceURI = "/Api/V1/ManagedDevice"
+)
+
+// DiscoveryPollInterval is the interval between two checks of the discovery job
+var DiscoveryPollInterval = 30 * time.Second
+
+// discoveryDeviceTypes maps the resource types of the discovery to the PowerFlex Manager device types
+var discoveryDeviceTypes = map[string]string{
+	RCNode.String():    "SERVER",
+	RCSwitch.String():  "SWITCH",
+	RCvCenter.String(): "VCENTER",
+}
+
+// DiscoverIPRangeDeviceRequest defines an IP range of the discovery request
+type DiscoverIPRangeDeviceRequest struct {
+	DeviceStartIP        string `json:"deviceStartIp"`
+	DeviceEndIP          string `json:"deviceEndIp,omitempty"`
+	DeviceType           string `json:"deviceType"`
+	DeviceServerCredRef  string `json:"deviceServerCredRef,omitempty"`
+	DeviceSwitchCredRef  string `json:"deviceSwitchCredRef,omitempty"`
+	DeviceVCenterCredRef string `json:"deviceVCenterCredRef,omitempty"`
+	Unmanaged            bool   `json:"unmanaged"`
+	Reserved             bool   `json:"reserved"`
+}
+
+// DiscoveryRequestList defines the list of IP ranges of the discovery request
+type DiscoveryRequestList struct {
+	DiscoverIPRangeDeviceRequest []DiscoverIPRangeDeviceRequest `json:"DiscoverIPRangeDeviceRequest"`
+}
+
+// DiscoveryRequest defines the discovery request
+type DiscoveryRequest struct {
+	DiscoveryRequestList DiscoveryRequestList `json:"discoveryRequestList"`
+}
+
+// DiscoveredDevice defines a device found by the discovery
+type DiscoveredDevice struct {
+	RefID         string `json:"refId"`
+	IPAddress     string `json:"ipAddress"`
+	DeviceType    string `json:"deviceType"`
+	ServiceTag    string `json:"serviceTag"`
+	Model         string `json:"model"`
+	Status        string `json:"status"`
+	StatusMessage string `json:"statusMessage"`
+}
+
+// DiscoveryResult defines the discovery job and its devices
+type DiscoveryResult struct {
+	ID            string             `json:"id"`
+	Status        string             `json:"status"`
+	StatusMessage string             `json:"statusMessage"`
+	Devices       []DiscoveredDevice `json:"devices"`
+}
+
+// GetDiscoveryRequest builds the discovery request from the plan
+func GetDiscoveryRequest(ctx context.Context, plan models.DiscoveryResourceModel) (*DiscoveryRequest, diag.Diagnostics) {
+	var ranges []models.DiscoveryIPRangeModel
+	diags := plan.IPRanges.ElementsAs(ctx, &ranges, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	resourceType := plan.ResourceType.ValueString()
+	deviceType, ok := discoveryDeviceTypes[resourceType]
+	if !ok {
+		diags.AddError("Invalid resource type", fmt.Sprintf("resource type %s cannot be discovered", resourceType))
+		return nil, diags
+	}
+
+	request := &DiscoveryRequest{}
+	for _, ipRange := range ranges {
+		deviceRequest := DiscoverIPRangeDeviceRequest{
+			DeviceStartIP: ipRange.StartIP.ValueString(),
+			DeviceEndIP:   ipRange.EndIP.ValueString(),
+			DeviceType:    deviceType,
+		}
+		if deviceRequest.DeviceEndIP == "" {
+			deviceRequest.DeviceEndIP = deviceRequest.DeviceStartIP
+		}
+		switch resourceType {
+		case RCNode.String():
+			deviceRequest.DeviceServerCredRef = plan.CredentialID.ValueString()
+		case RCSwitch.String():
+			deviceRequest.DeviceSwitchCredRef = plan.CredentialID.ValueString()
+		case RCvCenter.String():
+			deviceRequest.DeviceVCenterCredRef = plan.CredentialID.ValueString()
+		}
+		request.DiscoveryRequestList.DiscoverIPRangeDeviceRequest = append(request.DiscoveryRequestList.DiscoverIPRangeDeviceRequest, deviceRequest)
+	}
+	return request, diags
+}
+
+// StartDiscovery runs the discovery request and returns the ID of the discovery job
+func StartDiscovery(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, request *DiscoveryRequest) (string, error) {
+	result := &DiscoveryResult{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodPost, DiscoveryRequestURI, request, result)
+	if err != nil {
+		return "", err
+	}
+	if result.ID == "" {
+		return "", fmt.Errorf("discovery job ID is not returned by PowerFlex Manager")
+	}
+	return result.ID, nil
+}
+
+// GetDiscoveryResult returns the discovery job and its devices
+func GetDiscoveryResult(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string) (*DiscoveryResult, error) {
+	result := &DiscoveryResult{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, DiscoveryRequestURI+"/"+id, nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IsDiscoveryInProgress returns true if the discovery job is still running
+func IsDiscoveryInProgress(status string) bool {
+	switch strings.ToUpper(status) {
+	case "", "PENDING", "INPROGRESS", "IN_PROGRESS", "RUNNING":
+		return true
+	}
+	return false
+}
+
+// WaitForDiscovery waits until the discovery job is completed or the timeout expires
+func WaitForDiscovery(ctx context.Context, gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string, timeout time.Duration) (*DiscoveryResult, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		result, err := GetDiscoveryResult(gatewayClient, client, id)
+		if err != nil {
+			return nil, err
+		}
+		if !IsDiscoveryInProgress(result.Status) {
+			return result, nil
+		}
+		if time.Now().After(deadline) {
+			return result, fmt.Errorf("discovery job %s is not completed after %s", id, timeout)
+		}
+		tflog.Info(ctx, "Discovery is in progress", map[string]interface{}{"id": id, "devices": len(result.Devices)})
+		time.Sleep(DiscoveryPollInterval)
+	}
+}
+
+// GetInventoryRefIDs returns the reference IDs of the devices in the inventory of PowerFlex Manager
+func GetInventoryRefIDs(gatewayClient *goscaleio.GatewayClient) (map[string]bool, error) {
+	nodes, err := gatewayClient.GetAllNodes()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the inventory: %s", err.Error())
+	}
+	refIDs := map[string]bool{}
+	for _, node := range nodes {
+		refIDs[node.RefID] = true
+	}
+	return refIDs, nil
+}
+
+// GetAddedRefIDs returns the reference IDs of the discovered devices which were not in the inventory before the discovery
+func GetAddedRefIDs(inventory map[string]bool, result *DiscoveryResult) []string {
+	added := []string{}
+	for _, device := range result.Devices {
+		if device.RefID != "" && !inventory[device.RefID] {
+			added = append(added, device.RefID)
+		}
+	}
+	return added
+}
+
+// RemoveDiscoveredDevices removes the discovered devices from the inventory of PowerFlex Manager
+func RemoveDiscoveredDevices(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, refIDs []string) error {
+	for _, refID := range refIDs {
+		err := DoGatewayRequest(gatewayClient, client, http.MethodDelete, ManagedDeviceURI+"/"+refID, nil, nil)
+		if err != nil && !IsGatewayNotFoundError(err) {
+			return fmt.Errorf("could not remove device %s: %s", refID, err.Error())
+		}
+	}
+	return nil
+}
+
+// DiscoveredDeviceAttrTypes defines the attribute types of a discovered device
+var DiscoveredDeviceAttrTypes = map[string]attr.Type{
+	"ref_id":         types.StringType,
+	"ip_address":     types.StringType,
+	"device_type":    types.StringType,
+	"service_tag":    types.StringType,
+	"model":          types.StringType,
+	"status":         types.StringType,
+	"status_message": types.StringType,
+}
+
+// UpdateDiscoveryState sets the discovery job and its devices in the state
+func UpdateDiscoveryState(plan models.DiscoveryResourceModel, result *DiscoveryResult) (models.DiscoveryResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	state.ID = types.StringValue(result.ID)
+	state.Status = types.StringValue(result.Status)
+
+	refIDs := []string{}
+	devices := []models.DiscoveredDeviceModel{}
+	for _, device := range result.Devices {
+		if device.RefID != "" {
+			refIDs = append(refIDs, device.RefID)
+		}
+		devices = append(devices, models.DiscoveredDeviceModel{
+			RefID:         types.StringValue(device.RefID),
+			IPAddress:     types.StringValue(device.IPAddress),
+			DeviceType:    types.StringValue(device.DeviceType),
+			ServiceTag:    types.StringValue(device.ServiceTag),
+			Model:         types.StringValue(device.Model),
+			Status:        types.StringValue(device.Status),
+			StatusMessage: types.StringValue(device.StatusMessage),
+		})
+	}
+
+	refIDList, dgs := types.ListValueFrom(context.Background(), types.StringType, refIDs)
+	diags.Append(dgs...)
+	state.RefIDs = refIDList
+
+	deviceList, dgs := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: DiscoveredDeviceAttrTypes}, devices)
+	diags.Append(dgs...)
+	state.DiscoveredDevices = deviceList
+	return state, diags
+}
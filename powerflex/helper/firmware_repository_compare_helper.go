@@ -0,0 +1,252 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+	"unicode"
+
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// FirmwareChangeUpgrade is the change of a component whose version is newer in the target repository
+	FirmwareChangeUpgrade = "upgrade"
+	// FirmwareChangeDowngrade is the change of a component whose version is older in the target repository
+	FirmwareChangeDowngrade = "downgrade"
+	// FirmwareChangeMissing is the change of a component which is not part of the target repository
+	FirmwareChangeMissing = "missing"
+	// FirmwareChangeNew is the change of a component which is only part of the target repository
+	FirmwareChangeNew = "new"
+	// FirmwareChangeUnchanged is the change of a component whose version is the same in the target repository
+	FirmwareChangeUnchanged = "unchanged"
+)
+
+// CompareFirmwareVersions compares two firmware versions part by part, numerically when both parts are numbers.
+// It returns a negative number when a is older than b, 0 when they are the same and a positive number when a is newer.
+func CompareFirmwareVersions(a, b string) int {
+	isSeparator := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	partsA := strings.FieldsFunc(a, isSeparator)
+	partsB := strings.FieldsFunc(b, isSeparator)
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		numA, errA := strconv.ParseInt(partsA[i], 10, 64)
+		numB, errB := strconv.ParseInt(partsB[i], 10, 64)
+		if errA == nil && errB == nil {
+			if numA != numB {
+				if numA < numB {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+		if c := strings.Compare(strings.ToLower(partsA[i]), strings.ToLower(partsB[i])); c != 0 {
+			return c
+		}
+	}
+	return len(partsA) - len(partsB)
+}
+
+// GetFirmwareVersionChange returns the change of a component from the source version to the target version
+func GetFirmwareVersionChange(sourceVersion, targetVersion string) string {
+	switch {
+	case targetVersion == "":
+		return FirmwareChangeMissing
+	case sourceVersion == "":
+		return FirmwareChangeNew
+	}
+	c := CompareFirmwareVersions(sourceVersion, targetVersion)
+	switch {
+	case c < 0:
+		return FirmwareChangeUpgrade
+	case c > 0:
+		return FirmwareChangeDowngrade
+	}
+	return FirmwareChangeUnchanged
+}
+
+// getFirmwareComponentVersion returns the Dell version of the component, or its vendor version if unknown
+func getFirmwareComponentVersion(component scaleiotypes.Component) string {
+	if component.DellVersion != "" {
+		return component.DellVersion
+	}
+	return component.VendorVersion
+}
+
+// getFirmwareComponentKey returns the key which identifies the component across repositories
+func getFirmwareComponentKey(component scaleiotypes.Component) string {
+	return strings.Join([]string{
+		component.ComponentID,
+		component.DeviceID,
+		component.SubDeviceID,
+		component.VendorID,
+		component.SubVendorID,
+		component.OperatingSystem,
+	}, ":")
+}
+
+// GetRepositoryComponents returns the components of the firmware repository and of its bundles by key
+func GetRepositoryComponents(repository *scaleiotypes.FirmwareRepositoryDetails) map[string]scaleiotypes.Component {
+	components := make(map[string]scaleiotypes.Component)
+	add := func(list []scaleiotypes.Component) {
+		for _, component := range list {
+			key := getFirmwareComponentKey(component)
+			if _, ok := components[key]; !ok {
+				components[key] = component
+			}
+		}
+	}
+	add(repository.SoftwareComponents)
+	for _, bundle := range repository.SoftwareBundles {
+		add(bundle.SoftwareComponents)
+	}
+	return components
+}
+
+// newFirmwareComponentDiff returns the difference of the component between the source and the target version
+func newFirmwareComponentDiff(component scaleiotypes.Component, node, sourceVersion, targetVersion string) models.FirmwareComponentDiffModel {
+	return models.FirmwareComponentDiffModel{
+		Name:            types.StringValue(component.Name),
+		ComponentType:   types.StringValue(component.ComponentType),
+		OperatingSystem: types.StringValue(component.OperatingSystem),
+		Node:            types.StringValue(node),
+		SourceVersion:   types.StringValue(sourceVersion),
+		TargetVersion:   types.StringValue(targetVersion),
+		Change:          types.StringValue(GetFirmwareVersionChange(sourceVersion, targetVersion)),
+	}
+}
+
+// CompareFirmwareRepositories returns the differences of the components between the source and the target repository
+func CompareFirmwareRepositories(source, target *scaleiotypes.FirmwareRepositoryDetails, includeUnchanged bool) []models.FirmwareComponentDiffModel {
+	sourceComponents := GetRepositoryComponents(source)
+	targetComponents := GetRepositoryComponents(target)
+
+	diffs := make([]models.FirmwareComponentDiffModel, 0)
+	for key, component := range sourceComponents {
+		targetVersion := ""
+		if targetComponent, ok := targetComponents[key]; ok {
+			targetVersion = getFirmwareComponentVersion(targetComponent)
+		}
+		diffs = append(diffs, newFirmwareComponentDiff(component, "", getFirmwareComponentVersion(component), targetVersion))
+	}
+	for key, component := range targetComponents {
+		if _, ok := sourceComponents[key]; !ok {
+			diffs = append(diffs, newFirmwareComponentDiff(component, "", "", getFirmwareComponentVersion(component)))
+		}
+	}
+	return sortFirmwareComponentDiffs(diffs, includeUnchanged)
+}
+
+// getComplianceReportComponent returns the component of the repository of the resource group which is reported in the compliance report
+func getComplianceReportComponent(components map[string]scaleiotypes.Component, installed scaleiotypes.ComplianceReportComponents) (scaleiotypes.Component, bool) {
+	for _, id := range []string{installed.TargetVersion.ID, installed.CurrentVersion.ID} {
+		if component, ok := components[id]; ok && id != "" {
+			return component, true
+		}
+	}
+	return scaleiotypes.Component{}, false
+}
+
+// CompareResourceGroupFirmware returns the differences between the components installed on the nodes of the resource group and the target repository.
+// The components of the compliance report are resolved by ID in the repository of the resource group,
+// and matched with the components of the target repository by their component and device identifiers.
+// A component which cannot be resolved is reported as missing in the target repository.
+func CompareResourceGroupFirmware(reports []scaleiotypes.ComplianceReport, source, target *scaleiotypes.FirmwareRepositoryDetails, includeUnchanged bool) ([]models.FirmwareComponentDiffModel, error) {
+	nodes, err := GetRemediationNodes(reports, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceComponents := make(map[string]scaleiotypes.Component)
+	if source != nil {
+		for _, component := range source.SoftwareComponents {
+			sourceComponents[component.ID] = component
+		}
+		for _, bundle := range source.SoftwareBundles {
+			for _, component := range bundle.SoftwareComponents {
+				sourceComponents[component.ID] = component
+			}
+		}
+	}
+	targetComponents := GetRepositoryComponents(target)
+
+	diffs := make([]models.FirmwareComponentDiffModel, 0)
+	for _, node := range nodes {
+		for _, installed := range node.ComplianceReportComponents {
+			component := scaleiotypes.Component{Name: installed.Name, OperatingSystem: installed.OperatingSystem}
+			targetVersion := ""
+			if sourceComponent, ok := getComplianceReportComponent(sourceComponents, installed); ok {
+				component = sourceComponent
+				if targetComponent, ok := targetComponents[getFirmwareComponentKey(sourceComponent)]; ok {
+					component = targetComponent
+					targetVersion = getFirmwareComponentVersion(targetComponent)
+				}
+			}
+			component.Name = installed.Name
+			diffs = append(diffs, newFirmwareComponentDiff(component, node.ServiceTag, installed.CurrentVersion.FirmwareVersion, targetVersion))
+		}
+	}
+	return sortFirmwareComponentDiffs(diffs, includeUnchanged), nil
+}
+
+// sortFirmwareComponentDiffs sorts the differences by node, name and version and drops the unchanged components unless requested
+func sortFirmwareComponentDiffs(diffs []models.FirmwareComponentDiffModel, includeUnchanged bool) []models.FirmwareComponentDiffModel {
+	result := make([]models.FirmwareComponentDiffModel, 0, len(diffs))
+	for _, diff := range diffs {
+		if includeUnchanged || diff.Change.ValueString() != FirmwareChangeUnchanged {
+			result = append(result, diff)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Node.ValueString() != result[j].Node.ValueString() {
+			return result[i].Node.ValueString() < result[j].Node.ValueString()
+		}
+		if result[i].Name.ValueString() != result[j].Name.ValueString() {
+			return result[i].Name.ValueString() < result[j].Name.ValueString()
+		}
+		if result[i].OperatingSystem.ValueString() != result[j].OperatingSystem.ValueString() {
+			return result[i].OperatingSystem.ValueString() < result[j].OperatingSystem.ValueString()
+		}
+		if result[i].SourceVersion.ValueString() != result[j].SourceVersion.ValueString() {
+			return result[i].SourceVersion.ValueString() < result[j].SourceVersion.ValueString()
+		}
+		return result[i].TargetVersion.ValueString() < result[j].TargetVersion.ValueString()
+	})
+	return result
+}
+
+// GetFirmwareDiffCounts returns the number of components which would be upgraded, downgraded or are missing in the target repository
+func GetFirmwareDiffCounts(diffs []models.FirmwareComponentDiffModel) (upgrades, downgrades, missing int64) {
+	for _, diff := range diffs {
+		switch diff.Change.ValueString() {
+		case FirmwareChangeUpgrade:
+			upgrades++
+		case FirmwareChangeDowngrade:
+			downgrades++
+		case FirmwareChangeMissing:
+			missing++
+		}
+	}
+	return
+}
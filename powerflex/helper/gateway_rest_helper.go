@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dell/goscaleio"
+	"github.com/dell/goscaleio/api"
+	goscaleio_types "github.com/dell/goscaleio/types/v1"
+)
+
+// gatewayAPIVersion makes the REST client use bearer authentication, which is expected by PowerFlex Manager
+const gatewayAPIVersion = "4.0"
+
+// DoGatewayRequest sends a request to the PowerFlex Manager REST API for the operations which are not yet wrapped by goscaleio.
+// The token is generated by the gateway client, while the endpoint is the one the provider is configured with.
+func DoGatewayRequest(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, method, uri string, body, resp interface{}) error {
+	if gatewayClient == nil || client == nil {
+		return fmt.Errorf("PowerFlex gateway client is not initialized")
+	}
+	configConnect := client.GetConfigConnect()
+
+	token, err := gatewayClient.NewTokenGeneration()
+	if err != nil {
+		return fmt.Errorf("Error generating token for PowerFlex Manager: %s", err.Error())
+	}
+
+	apiClient, err := api.New(context.Background(), configConnect.Endpoint, api.ClientOptions{
+		Insecure: configConnect.Insecure,
+		UseCerts: true,
+	}, false)
+	if err != nil {
+		return fmt.Errorf("Unable to create PowerFlex Manager REST client: %s", err.Error())
+	}
+	apiClient.SetToken(token)
+
+	headers := map[string]string{
+		api.HeaderKeyAccept:      api.HeaderValContentTypeJSON,
+		api.HeaderKeyContentType: api.HeaderValContentTypeJSON,
+	}
+	return apiClient.DoWithHeaders(context.Background(), method, uri, headers, body, resp, gatewayAPIVersion)
+}
+
+// IsGatewayNotFoundError returns true if the PowerFlex Manager REST API reports that the requested object does not exist
+func IsGatewayNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiError *goscaleio_types.Error
+	if errors.As(err, &apiError) && apiError.HTTPStatusCode == http.StatusNotFound {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "not found")
+}
@@ -0,0 +1,411 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	goscaleio_types "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// InstallerOperationCompleted is the status of an installer operation whose phases are all completed
+	InstallerOperationCompleted = "Completed"
+	// InstallerOperationFailed is the status of an installer operation with failed commands
+	InstallerOperationFailed = "Failed"
+	// InstallerOperationTimedOut is the status of an installer operation whose commands are running for too long
+	InstallerOperationTimedOut = "TimedOut"
+
+	// InstallationFailureResume keeps the installer as is after a failure, so that the next apply resumes it
+	InstallationFailureResume = "resume"
+	// InstallationFailureRollback aborts the installer and uninstalls the cluster after a failure
+	InstallationFailureRollback = "rollback"
+)
+
+// InstallerPhases are the phases of the gateway installer for an installation, in order
+var InstallerPhases = []string{"query", "upload", "install", "configure"}
+
+// InstallerPollInterval is the interval between two checks of the installer queue
+var InstallerPollInterval = 1 * time.Minute
+
+// InstallerMaxRunningChecks is the number of consecutive checks after which a running phase is timed out
+var InstallerMaxRunningChecks = 6
+
+// InstallerOperation defines the progress of an operation of the gateway installer
+type InstallerOperation struct {
+	ID             string
+	Phase          string
+	Status         string
+	FailedCommands []string
+	NodeIPs        []string
+}
+
+// InstallerOperationError is returned when a phase of the gateway installer fails or times out
+type InstallerOperationError struct {
+	Operation *InstallerOperation
+	Message   string
+}
+
+// Error returns the message of the failed installer operation with its last phase and failed commands
+func (e *InstallerOperationError) Error() string {
+	msg := fmt.Sprintf("%s (operation %s, phase %s)", e.Message, e.Operation.ID, e.Operation.Phase)
+	for _, command := range e.Operation.FailedCommands {
+		msg += "\n - " + command
+	}
+	return msg
+}
+
+// GetInstallerOperationID returns the ID of the operation of the installer queue.
+// It is derived from the start time of the earliest command, so that it identifies the operation across applies.
+func GetInstallerOperationID(commands []goscaleio_types.MDMQueueCommandDetails) string {
+	var start time.Time
+	for _, command := range commands {
+		if !command.StartTime.IsZero() && (start.IsZero() || command.StartTime.Before(start)) {
+			start = command.StartTime
+		}
+	}
+	if start.IsZero() {
+		return ""
+	}
+	return "installer-" + strconv.FormatInt(start.Unix(), 10)
+}
+
+// GetInstallerCurrentPhase returns the latest phase which has commands in the installer queue
+func GetInstallerCurrentPhase(commands []goscaleio_types.MDMQueueCommandDetails) string {
+	current := -1
+	for _, command := range commands {
+		for i, phase := range InstallerPhases {
+			if command.AllowedPhase == phase && i > current {
+				current = i
+			}
+		}
+	}
+	if current < 0 {
+		return ""
+	}
+	return InstallerPhases[current]
+}
+
+// GetInstallerFailedCommands returns the commands of the phase which failed, or which are still running if running is true
+func GetInstallerFailedCommands(commands []goscaleio_types.MDMQueueCommandDetails, phase string, running bool) []string {
+	failed := []string{}
+	for _, command := range commands {
+		if command.AllowedPhase != phase {
+			continue
+		}
+		if command.CommandState == "failed" || (running && (command.CommandState == "pending" || command.CommandState == "running")) {
+			failed = append(failed, fmt.Sprintf("%s: %s %s %s", command.TargetEntityIdentifier, command.CommandName, command.CommandState, command.Message))
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
+
+// GetInstallerNodeIPs returns the IPs of the nodes targeted by the commands of the installer queue
+func GetInstallerNodeIPs(commands []goscaleio_types.MDMQueueCommandDetails) []string {
+	ips := []string{}
+	for _, command := range commands {
+		ips = append(ips, command.NodeIPs...)
+	}
+	sort.Strings(ips)
+	return removeDuplicates(ips)
+}
+
+// GetClusterNodeIPs returns all the IPs configured for the nodes of the cluster list
+func GetClusterNodeIPs(nodes []models.ClusterModel) map[string]bool {
+	ips := map[string]bool{}
+	for _, node := range nodes {
+		for _, value := range []types.String{node.IP, node.MDMIP, node.MDMMgmtIP, node.SDSAllIPs, node.SDSToSDSOnlyIPs, node.SDSToSDCOnlyIPs,
+			node.SDRApplicationIPs, node.SDRStorageIPs, node.SDRExternalIPs, node.SDRAllIPS, node.SDTAllIPs} {
+			for _, ip := range strings.Split(value.ValueString(), ",") {
+				if ip = strings.TrimSpace(ip); ip != "" {
+					ips[ip] = true
+				}
+			}
+		}
+	}
+	return ips
+}
+
+// IsInstallerOperationOfNodes returns true if all the nodes targeted by the operation are configured in the cluster list
+func IsInstallerOperationOfNodes(operation *InstallerOperation, nodes []models.ClusterModel) bool {
+	if len(operation.NodeIPs) == 0 {
+		return false
+	}
+	configured := GetClusterNodeIPs(nodes)
+	for _, ip := range operation.NodeIPs {
+		if !configured[ip] {
+			return false
+		}
+	}
+	return true
+}
+
+// GetRecordedInstallerOperation returns the operation of the installer queue if it is the recorded failed operation and it targets the configured nodes.
+// It returns nil if the installer queue holds any other operation, which must not be resumed.
+func GetRecordedInstallerOperation(gatewayClient *goscaleio.GatewayClient, recorded *InstallerOperation, nodes []models.ClusterModel) (*InstallerOperation, error) {
+	if !IsInstallerOperationPending(recorded) || recorded.ID == "" {
+		return nil, nil
+	}
+	operation, err := GetResumableInstallerOperation(gatewayClient)
+	if err != nil || operation == nil {
+		return nil, err
+	}
+	if operation.ID != recorded.ID || !IsInstallerOperationOfNodes(operation, nodes) {
+		return nil, nil
+	}
+	return operation, nil
+}
+
+// GetResumableInstallerOperation returns the operation left in the installer queue by a failed installation, or nil if there is none
+func GetResumableInstallerOperation(gatewayClient *goscaleio.GatewayClient) (*InstallerOperation, error) {
+	commands, err := gatewayClient.GetInQueueCommand()
+	if err != nil {
+		return nil, fmt.Errorf("Error while getting the installer queue is %s", err.Error())
+	}
+	phase := GetInstallerCurrentPhase(commands)
+	if phase == "" {
+		return nil, nil
+	}
+	operation := &InstallerOperation{
+		ID:             GetInstallerOperationID(commands),
+		Phase:          phase,
+		Status:         InstallerOperationFailed,
+		FailedCommands: GetInstallerFailedCommands(commands, phase, false),
+		NodeIPs:        GetInstallerNodeIPs(commands),
+	}
+	if len(operation.FailedCommands) == 0 {
+		operation.Status = InstallerOperationTimedOut
+		operation.FailedCommands = GetInstallerFailedCommands(commands, phase, true)
+		if len(operation.FailedCommands) == 0 && phase == InstallerPhases[len(InstallerPhases)-1] {
+			// the installation is completed
+			return nil, nil
+		}
+	}
+	return operation, nil
+}
+
+// ResumeClusterInstallation retries the failed phase of the operation left in the installer queue and runs the remaining phases
+func ResumeClusterInstallation(ctx context.Context, gatewayClient *goscaleio.GatewayClient, operation *InstallerOperation) (*InstallerOperation, error) {
+	tflog.Info(ctx, "Resuming gateway installer operation", map[string]interface{}{"operation": operation.ID, "phase": operation.Phase})
+	if operation.Status == InstallerOperationFailed {
+		response, err := gatewayClient.RetryPhase()
+		if err != nil {
+			return operation, fmt.Errorf("Error while retrying phase %s is %s", operation.Phase, err.Error())
+		}
+		if response.StatusCode != 200 {
+			return operation, fmt.Errorf("Message: %s, Error Code: %s", response.Message, strconv.Itoa(response.StatusCode))
+		}
+	}
+	start := 0
+	for i, phase := range InstallerPhases {
+		if phase == operation.Phase {
+			start = i
+		}
+	}
+	return runInstallerPhases(ctx, gatewayClient, start)
+}
+
+// runInstallerPhases waits for the phases of the installer, starting from the given phase, and moves to the next phase once completed.
+// When a phase fails or times out, the installer is left as is, so that the operation can be resumed or rolled back.
+func runInstallerPhases(ctx context.Context, gatewayClient *goscaleio.GatewayClient, start int) (*InstallerOperation, error) {
+	operation := &InstallerOperation{}
+	for i := start; i < len(InstallerPhases); i++ {
+		operation.Phase = InstallerPhases[i]
+		tflog.Info(ctx, "Gateway Installation phase started", map[string]interface{}{"phase": operation.Phase})
+
+		for runningChecks := 0; ; {
+			time.Sleep(InstallerPollInterval)
+
+			commands, err := gatewayClient.GetInQueueCommand()
+			if err != nil {
+				return operation, fmt.Errorf("Error while getting the installer queue is %s", err.Error())
+			}
+			if operation.ID == "" {
+				operation.ID = GetInstallerOperationID(commands)
+			}
+			logInstallerProgress(ctx, commands, operation.Phase)
+
+			checkForPhaseCompleted, err := gatewayClient.CheckForCompletionQueueCommands(operation.Phase)
+			if err != nil {
+				return operation, fmt.Errorf("Error while checking the installer queue is %s", err.Error())
+			}
+
+			if checkForPhaseCompleted.Data == "Completed" {
+				break
+			}
+			if checkForPhaseCompleted.Data == "Running" {
+				runningChecks++
+				tflog.Info(ctx, "Gateway Installation operations are still running in phase "+operation.Phase)
+				if runningChecks < InstallerMaxRunningChecks {
+					continue
+				}
+				operation.Status = InstallerOperationTimedOut
+				operation.FailedCommands = GetInstallerFailedCommands(commands, operation.Phase, true)
+				return operation, &InstallerOperationError{Operation: operation, Message: "Time Out,Some Operations of Installer running from since long"}
+			}
+			operation.Status = InstallerOperationFailed
+			operation.FailedCommands = GetInstallerFailedCommands(commands, operation.Phase, false)
+			return operation, &InstallerOperationError{Operation: operation, Message: "Error During Installation is " + checkForPhaseCompleted.Message}
+		}
+
+		tflog.Info(ctx, "Gateway Installation phase completed", map[string]interface{}{"phase": operation.Phase})
+		if i == len(InstallerPhases)-1 {
+			break
+		}
+
+		moveToNextPhaseResponse, err := gatewayClient.MoveToNextPhase()
+		if err != nil {
+			return operation, fmt.Errorf("Error while moving to next phase is %s", err.Error())
+		}
+		if moveToNextPhaseResponse.StatusCode != 200 {
+			return operation, fmt.Errorf("Messsage: %s, Error Code: %s", moveToNextPhaseResponse.Message, strconv.Itoa(moveToNextPhaseResponse.StatusCode))
+		}
+	}
+
+	// to make gateway available for installation
+	queueOperationError := ResetInstallerQueue(gatewayClient)
+	if queueOperationError != nil {
+		return operation, fmt.Errorf("Error Clearing Queue During Installation is %s", queueOperationError.Error())
+	}
+
+	operation.Status = InstallerOperationCompleted
+	operation.FailedCommands = []string{}
+	return operation, nil
+}
+
+// logInstallerProgress logs the state of the commands of the phase for each node
+func logInstallerProgress(ctx context.Context, commands []goscaleio_types.MDMQueueCommandDetails, phase string) {
+	progress := map[string]map[string]int{}
+	for _, command := range commands {
+		if command.AllowedPhase != phase {
+			continue
+		}
+		node := command.TargetEntityIdentifier
+		if progress[node] == nil {
+			progress[node] = map[string]int{}
+		}
+		progress[node][command.CommandState]++
+	}
+
+	nodes := make([]string, 0, len(progress))
+	for node := range progress {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	for _, node := range nodes {
+		tflog.Info(ctx, "Gateway Installation progress", map[string]interface{}{
+			"phase":     phase,
+			"node":      node,
+			"completed": progress[node]["completed"],
+			"running":   progress[node]["running"],
+			"pending":   progress[node]["pending"],
+			"failed":    progress[node]["failed"],
+		})
+	}
+}
+
+// InstallerOperationAttrTypes defines the attribute types of the installer operation
+var InstallerOperationAttrTypes = map[string]attr.Type{
+	"id":              types.StringType,
+	"phase":           types.StringType,
+	"status":          types.StringType,
+	"failed_commands": types.ListType{ElemType: types.StringType},
+}
+
+// GetInstallerOperationValue converts the installer operation to its terraform value
+func GetInstallerOperationValue(operation *InstallerOperation) (types.Object, diag.Diagnostics) {
+	if operation == nil {
+		return types.ObjectNull(InstallerOperationAttrTypes), nil
+	}
+	failedCommands := operation.FailedCommands
+	if failedCommands == nil {
+		failedCommands = []string{}
+	}
+	return types.ObjectValueFrom(context.Background(), InstallerOperationAttrTypes, models.InstallerOperationModel{
+		ID:             types.StringValue(operation.ID),
+		Phase:          types.StringValue(operation.Phase),
+		Status:         types.StringValue(operation.Status),
+		FailedCommands: failedCommands,
+	})
+}
+
+// GetInstallerOperationFromValue converts the terraform value of the installer operation, it returns nil if it is not set
+func GetInstallerOperationFromValue(value types.Object) (*InstallerOperation, diag.Diagnostics) {
+	if !Known(value) {
+		return nil, nil
+	}
+	var model models.InstallerOperationModel
+	diags := value.As(context.Background(), &model, basetypes.ObjectAsOptions{})
+	return &InstallerOperation{
+		ID:             model.ID.ValueString(),
+		Phase:          model.Phase.ValueString(),
+		Status:         model.Status.ValueString(),
+		FailedCommands: model.FailedCommands,
+	}, diags
+}
+
+// ClusterFailedInstallationState returns the state of a cluster whose installation failed, so that the failed operation is persisted.
+// The state is identified by the failed operation until the cluster is installed.
+func ClusterFailedInstallationState(ctx context.Context, plan models.ClusterResourceModel, operation *InstallerOperation) (models.ClusterResourceModel, diag.Diagnostics) {
+	state := plan
+	operationValue, diags := GetInstallerOperationValue(operation)
+	state.ID = types.StringValue(operation.ID)
+	state.InstallerOperation = operationValue
+	if state.MDMList.IsUnknown() {
+		state.MDMList = types.SetNull(state.MDMList.ElementType(ctx))
+	}
+	if state.SDSList.IsUnknown() {
+		state.SDSList = types.SetNull(state.SDSList.ElementType(ctx))
+	}
+	if state.SDCList.IsUnknown() {
+		state.SDCList = types.SetNull(state.SDCList.ElementType(ctx))
+	}
+	if state.SDRList.IsUnknown() {
+		state.SDRList = types.SetNull(state.SDRList.ElementType(ctx))
+	}
+	if state.SDTList.IsUnknown() {
+		state.SDTList = types.SetNull(state.SDTList.ElementType(ctx))
+	}
+	if state.ProtectionDomains.IsUnknown() {
+		state.ProtectionDomains = types.ListNull(state.ProtectionDomains.ElementType(ctx))
+	}
+	return state, diags
+}
+
+// IsInstallerOperationInstalling returns true if the operation reached a phase which installs components on the nodes
+func IsInstallerOperationInstalling(operation *InstallerOperation) bool {
+	return operation.Phase != InstallerPhases[0] && operation.Phase != InstallerPhases[1]
+}
+
+// IsInstallerOperationPending returns true if the last installer operation of the state is not completed
+func IsInstallerOperationPending(operation *InstallerOperation) bool {
+	return operation != nil && operation.Status != InstallerOperationCompleted
+}
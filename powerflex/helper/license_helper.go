@@ -0,0 +1,122 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LicenseParam defines the payload for installing a license on the PowerFlex cluster
+type LicenseParam struct {
+	LicenseKey string `json:"licenseKey"`
+}
+
+// GetLicenseDetails returns the system instance which holds the license details of the PowerFlex cluster
+func GetLicenseDetails(client *goscaleio.Client) (*scaleiotypes.System, error) {
+	systems, err := client.GetInstance("")
+	if err != nil {
+		return nil, err
+	}
+	if len(systems) == 0 {
+		return nil, fmt.Errorf("no systems found")
+	}
+	return systems[0], nil
+}
+
+// GetUsedCapacityInKb returns the capacity in use on the PowerFlex cluster
+func GetUsedCapacityInKb(system *goscaleio.System) (int, error) {
+	statistics, err := system.GetStatistics()
+	if err != nil {
+		return 0, err
+	}
+	return statistics.CapacityInUseInKb, nil
+}
+
+// ReadLicenseFile returns the license key in the license file
+func ReadLicenseFile(licenseFilePath string) (string, error) {
+	data, err := os.ReadFile(licenseFilePath)
+	if err != nil {
+		return "", fmt.Errorf("Could not read license file, make sure path to license file is correct: %s", err.Error())
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// GetLicenseFileHash returns the SHA-256 hash of the license key in the license file
+func GetLicenseFileHash(licenseFilePath string) (string, error) {
+	licenseKey, err := ReadLicenseFile(licenseFilePath)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(licenseKey))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// SetLicense installs the license file on the PowerFlex cluster
+func SetLicense(client *goscaleio.Client, systemID string, licenseFilePath string) error {
+	licenseKey, err := ReadLicenseFile(licenseFilePath)
+	if err != nil {
+		return err
+	}
+
+	payload := LicenseParam{
+		LicenseKey: licenseKey,
+	}
+	path := fmt.Sprintf("/api/instances/System::%v/action/setLicense", systemID)
+	return DoRestRequest(client, http.MethodPost, path, payload, nil)
+}
+
+// MapLicenseResourceState maps the license details to the license resource state
+func MapLicenseResourceState(system *scaleiotypes.System, plan models.LicenseResourceModel) models.LicenseResourceModel {
+	return models.LicenseResourceModel{
+		ID:                        types.StringValue(system.ID),
+		LicenseFilePath:           plan.LicenseFilePath,
+		LicenseFileHash:           plan.LicenseFileHash,
+		InstallationID:            types.StringValue(system.InstallID),
+		Swid:                      types.StringValue(system.Swid),
+		MaxCapacityInGb:           types.StringValue(system.MaxCapacityInGb),
+		CapacityTimeLeftInDays:    types.StringValue(system.CapacityTimeLeftInDays),
+		DaysInstalled:             types.Int64Value(int64(system.DaysInstalled)),
+		EnterpriseFeaturesEnabled: types.BoolValue(system.EnterpriseFeaturesEnabled),
+		IsInitialLicense:          types.BoolValue(system.IsInitialLicense),
+	}
+}
+
+// MapLicenseDataSourceState maps the license details to the license datasource state
+func MapLicenseDataSourceState(system *scaleiotypes.System, usedCapacityInKb int) models.LicenseDataSourceModel {
+	return models.LicenseDataSourceModel{
+		ID:                        types.StringValue(system.ID),
+		InstallationID:            types.StringValue(system.InstallID),
+		Swid:                      types.StringValue(system.Swid),
+		MaxCapacityInGb:           types.StringValue(system.MaxCapacityInGb),
+		UsedCapacityInGb:          types.Float64Value(float64(usedCapacityInKb) / (1024 * 1024)),
+		CapacityTimeLeftInDays:    types.StringValue(system.CapacityTimeLeftInDays),
+		DaysInstalled:             types.Int64Value(int64(system.DaysInstalled)),
+		EnterpriseFeaturesEnabled: types.BoolValue(system.EnterpriseFeaturesEnabled),
+		IsInitialLicense:          types.BoolValue(system.IsInitialLicense),
+	}
+}
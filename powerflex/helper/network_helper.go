@@ -0,0 +1,275 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkURI is the PowerFlex Manager URI of the networks
+const NetworkURI = "/Api/V1/Network"
+
+// NetworkIPRangeAttrTypes defines the attribute types of an IP range of the network
+var NetworkIPRangeAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"start_ip": types.StringType,
+	"end_ip":   types.StringType,
+	"role":     types.StringType,
+}
+
+// NetworkStaticRouteAttrTypes defines the attribute types of a static route of the network
+var NetworkStaticRouteAttrTypes = map[string]attr.Type{
+	"source_network_id":      types.StringType,
+	"destination_network_id": types.StringType,
+	"gateway":                types.StringType,
+	"subnet_mask":            types.StringType,
+	"destination_ip_address": types.StringType,
+}
+
+// GetAllNetworks returns all the networks of PowerFlex Manager
+func GetAllNetworks(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) ([]scaleiotypes.Networks, error) {
+	networks := make([]scaleiotypes.Networks, 0)
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, NetworkURI, nil, &networks)
+	if err != nil {
+		return nil, err
+	}
+	return networks, nil
+}
+
+// GetNetworkByID returns the network with the given ID
+func GetNetworkByID(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string) (*scaleiotypes.Networks, error) {
+	network := &scaleiotypes.Networks{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, NetworkURI+"/"+id, nil, network)
+	if err != nil {
+		return nil, err
+	}
+	if network.ID == "" {
+		return nil, fmt.Errorf("network %s is not found", id)
+	}
+	return network, nil
+}
+
+// CreateNetwork creates the network and returns its ID
+func CreateNetwork(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, network *scaleiotypes.Networks) (string, error) {
+	created := &scaleiotypes.Networks{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodPost, NetworkURI, network, created)
+	if err != nil {
+		return "", err
+	}
+	if created.ID != "" {
+		return created.ID, nil
+	}
+
+	// the network is looked up by name when its ID is not returned
+	networks, err := GetAllNetworks(gatewayClient, client)
+	if err != nil {
+		return "", err
+	}
+	for _, item := range networks {
+		if item.Name == network.Name {
+			return item.ID, nil
+		}
+	}
+	return "", fmt.Errorf("network %s is not found after creation", network.Name)
+}
+
+// UpdateNetwork updates the network
+func UpdateNetwork(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, network *scaleiotypes.Networks) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPut, NetworkURI+"/"+network.ID, network, nil)
+}
+
+// DeleteNetwork deletes the network
+func DeleteNetwork(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodDelete, NetworkURI+"/"+id, nil, nil)
+}
+
+// GetNetworkPayload builds the network from the plan
+func GetNetworkPayload(ctx context.Context, plan models.NetworkResourceModel) (*scaleiotypes.Networks, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	network := &scaleiotypes.Networks{
+		ID:          plan.ID.ValueString(),
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Type:        plan.Type.ValueString(),
+		VlanID:      int(plan.VlanID.ValueInt64()),
+	}
+	if plan.ID.IsUnknown() {
+		network.ID = ""
+	}
+
+	var ipRanges []models.NetworkIPRangeModel
+	if !plan.IPRanges.IsNull() && !plan.IPRanges.IsUnknown() {
+		diags.Append(plan.IPRanges.ElementsAs(ctx, &ipRanges, false)...)
+	}
+	var staticRoutes []models.NetworkStaticRouteModel
+	if !plan.StaticRoutes.IsNull() && !plan.StaticRoutes.IsUnknown() {
+		diags.Append(plan.StaticRoutes.ElementsAs(ctx, &staticRoutes, false)...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// the network is static when a subnet is given, otherwise the addresses are assigned by DHCP
+	if plan.Subnet.ValueString() == "" {
+		if plan.Gateway.ValueString() != "" || plan.PrimaryDNS.ValueString() != "" || plan.SecondaryDNS.ValueString() != "" ||
+			plan.DNSSuffix.ValueString() != "" || len(ipRanges) > 0 || len(staticRoutes) > 0 {
+			diags.AddError(
+				"Invalid network configuration",
+				"subnet is required when gateway, DNS, IP ranges or static routes are specified",
+			)
+		}
+		return network, diags
+	}
+
+	network.Static = true
+	network.StaticNetworkConfiguration = scaleiotypes.StaticNetworkConfiguration{
+		Subnet:       plan.Subnet.ValueString(),
+		Gateway:      plan.Gateway.ValueString(),
+		PrimaryDNS:   plan.PrimaryDNS.ValueString(),
+		SecondaryDNS: plan.SecondaryDNS.ValueString(),
+		DNSSuffix:    plan.DNSSuffix.ValueString(),
+	}
+	for _, ipRange := range ipRanges {
+		item := scaleiotypes.IPRange{
+			StartingIP: ipRange.StartIP.ValueString(),
+			EndingIP:   ipRange.EndIP.ValueString(),
+			Role:       ipRange.Role.ValueString(),
+		}
+		if !ipRange.ID.IsUnknown() {
+			item.ID = ipRange.ID.ValueString()
+		}
+		network.StaticNetworkConfiguration.IPRange = append(network.StaticNetworkConfiguration.IPRange, item)
+	}
+	for _, route := range staticRoutes {
+		network.StaticNetworkConfiguration.StaticRoute = append(network.StaticNetworkConfiguration.StaticRoute, scaleiotypes.StaticRoute{
+			StaticRouteSourceNetworkID:      route.SourceNetworkID.ValueString(),
+			StaticRouteDestinationNetworkID: route.DestinationNetworkID.ValueString(),
+			StaticRouteGateway:              route.Gateway.ValueString(),
+			SubnetMask:                      route.SubnetMask.ValueString(),
+			DestinationIPAddress:            route.DestinationIPAddress.ValueString(),
+		})
+	}
+	return network, diags
+}
+
+// optionalNetworkString returns null for an empty value which is not set in the plan
+func optionalNetworkString(value string, plan types.String) types.String {
+	if value == "" && plan.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// GetNetworkModel converts the network of PowerFlex Manager to the model of the data source
+func GetNetworkModel(network scaleiotypes.Networks) models.NetworkModel {
+	model := models.NetworkModel{
+		ID:           types.StringValue(network.ID),
+		Name:         types.StringValue(network.Name),
+		Description:  types.StringValue(network.Description),
+		Type:         types.StringValue(network.Type),
+		VlanID:       types.Int64Value(int64(network.VlanID)),
+		Static:       types.BoolValue(network.Static),
+		Subnet:       types.StringValue(network.StaticNetworkConfiguration.Subnet),
+		Gateway:      types.StringValue(network.StaticNetworkConfiguration.Gateway),
+		PrimaryDNS:   types.StringValue(network.StaticNetworkConfiguration.PrimaryDNS),
+		SecondaryDNS: types.StringValue(network.StaticNetworkConfiguration.SecondaryDNS),
+		DNSSuffix:    types.StringValue(network.StaticNetworkConfiguration.DNSSuffix),
+		IPRanges:     make([]models.NetworkIPRangeModel, 0),
+		StaticRoutes: make([]models.NetworkStaticRouteModel, 0),
+	}
+	for _, ipRange := range network.StaticNetworkConfiguration.IPRange {
+		model.IPRanges = append(model.IPRanges, models.NetworkIPRangeModel{
+			ID:      types.StringValue(ipRange.ID),
+			StartIP: types.StringValue(ipRange.StartingIP),
+			EndIP:   types.StringValue(ipRange.EndingIP),
+			Role:    types.StringValue(ipRange.Role),
+		})
+	}
+	for _, route := range network.StaticNetworkConfiguration.StaticRoute {
+		model.StaticRoutes = append(model.StaticRoutes, models.NetworkStaticRouteModel{
+			SourceNetworkID:      types.StringValue(route.StaticRouteSourceNetworkID),
+			DestinationNetworkID: types.StringValue(route.StaticRouteDestinationNetworkID),
+			Gateway:              types.StringValue(route.StaticRouteGateway),
+			SubnetMask:           types.StringValue(route.SubnetMask),
+			DestinationIPAddress: types.StringValue(route.DestinationIPAddress),
+		})
+	}
+	return model
+}
+
+// UpdateNetworkState sets the network in the state
+func UpdateNetworkState(ctx context.Context, plan models.NetworkResourceModel, network *scaleiotypes.Networks) (models.NetworkResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	model := GetNetworkModel(*network)
+	state := plan
+	state.ID = model.ID
+	state.Name = model.Name
+	state.Description = optionalNetworkString(network.Description, plan.Description)
+	state.Type = model.Type
+	state.VlanID = model.VlanID
+	state.Static = model.Static
+	state.Subnet = optionalNetworkString(network.StaticNetworkConfiguration.Subnet, plan.Subnet)
+	state.Gateway = optionalNetworkString(network.StaticNetworkConfiguration.Gateway, plan.Gateway)
+	state.PrimaryDNS = optionalNetworkString(network.StaticNetworkConfiguration.PrimaryDNS, plan.PrimaryDNS)
+	state.SecondaryDNS = optionalNetworkString(network.StaticNetworkConfiguration.SecondaryDNS, plan.SecondaryDNS)
+	state.DNSSuffix = optionalNetworkString(network.StaticNetworkConfiguration.DNSSuffix, plan.DNSSuffix)
+
+	ipRangeType := types.ObjectType{AttrTypes: NetworkIPRangeAttrTypes}
+	if len(model.IPRanges) == 0 && plan.IPRanges.IsNull() {
+		state.IPRanges = types.ListNull(ipRangeType)
+	} else {
+		for i := range model.IPRanges {
+			model.IPRanges[i].Role = optionalNetworkString(model.IPRanges[i].Role.ValueString(), getNetworkIPRangeRole(ctx, plan, i))
+		}
+		ipRanges, dgs := types.ListValueFrom(ctx, ipRangeType, model.IPRanges)
+		diags.Append(dgs...)
+		state.IPRanges = ipRanges
+	}
+
+	staticRouteType := types.ObjectType{AttrTypes: NetworkStaticRouteAttrTypes}
+	if len(model.StaticRoutes) == 0 && plan.StaticRoutes.IsNull() {
+		state.StaticRoutes = types.ListNull(staticRouteType)
+	} else {
+		staticRoutes, dgs := types.ListValueFrom(ctx, staticRouteType, model.StaticRoutes)
+		diags.Append(dgs...)
+		state.StaticRoutes = staticRoutes
+	}
+	return state, diags
+}
+
+// getNetworkIPRangeRole returns the role of the IP range of the plan at the given index
+func getNetworkIPRangeRole(ctx context.Context, plan models.NetworkResourceModel, index int) types.String {
+	var ipRanges []models.NetworkIPRangeModel
+	if plan.IPRanges.IsNull() || plan.IPRanges.IsUnknown() {
+		return types.StringNull()
+	}
+	plan.IPRanges.ElementsAs(ctx, &ipRanges, false)
+	if index >= len(ipRanges) {
+		return types.StringNull()
+	}
+	return ipRanges[index].Role
+}
@@ -24,8 +24,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// GetNodeState returns the state for node data source
-func GetNodeState(node scaleiotypes.NodeDetails) (response models.NodeModel) {
+// GetNodeState returns the state for node data source, with the date of the last inventory of the node
+func GetNodeState(node scaleiotypes.NodeDetails, lastRefreshDate string) (response models.NodeModel) {
 	response = models.NodeModel{
 		RefID:               types.StringValue(node.RefID),
 		IPAddress:           types.StringValue(node.IPAddress),
@@ -50,6 +50,7 @@ func GetNodeState(node scaleiotypes.NodeDetails) (response models.NodeModel) {
 		MemoryInGB:          types.Int64Value(int64(node.MemoryInGB)),
 		ComplianceCheckDate: types.StringValue(node.ComplianceCheckDate),
 		DiscoveredDate:      types.StringValue(node.DiscoveredDate),
+		LastRefreshDate:     types.StringValue(lastRefreshDate),
 		CredID:              types.StringValue(node.CredID),
 		Compliance:          types.StringValue(node.Compliance),
 		FailuresCount:       types.Int64Value(int64(node.FailuresCount)),
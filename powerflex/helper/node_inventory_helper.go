@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// NodeInventoryRefreshURI is the PowerFlex Manager URI to refresh the inventory and health of the devices
+const NodeInventoryRefreshURI = "/Api/V1/ManagedDevice/inventory"
+
+// NodeInventoryPollInterval is the interval between two checks of the nodes during the inventory refresh
+var NodeInventoryPollInterval = 30 * time.Second
+
+// NodeInventoryAttrTypes defines the attribute types of the inventory of a node
+var NodeInventoryAttrTypes = map[string]attr.Type{
+	"ref_id":            types.StringType,
+	"service_tag":       types.StringType,
+	"ip_address":        types.StringType,
+	"state":             types.StringType,
+	"health":            types.StringType,
+	"health_message":    types.StringType,
+	"last_refresh_date": types.StringType,
+}
+
+// NodeInventory defines the inventory fields of a device of PowerFlex Manager
+type NodeInventory struct {
+	RefID         string `json:"refId"`
+	ServiceTag    string `json:"serviceTag"`
+	IPAddress     string `json:"ipAddress"`
+	State         string `json:"state"`
+	Health        string `json:"health"`
+	HealthMessage string `json:"healthMessage"`
+	InventoryDate string `json:"inventoryDate"`
+}
+
+// NodeInventoryRefreshRequest defines the request to refresh the inventory of the devices
+type NodeInventoryRefreshRequest struct {
+	RefIDs []string `json:"refIds"`
+}
+
+// LastRefreshDate returns the date of the last inventory of the node, which is empty until the node is inventoried
+func (n NodeInventory) LastRefreshDate() string {
+	return n.InventoryDate
+}
+
+// GetNodeLastRefreshDates returns the date of the last inventory of the devices, keyed by reference ID
+func GetNodeLastRefreshDates(inventories []NodeInventory) map[string]string {
+	dates := make(map[string]string, len(inventories))
+	for _, inventory := range inventories {
+		dates[inventory.RefID] = inventory.LastRefreshDate()
+	}
+	return dates
+}
+
+// GetNodeInventories returns the inventory of all the devices of PowerFlex Manager
+func GetNodeInventories(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) ([]NodeInventory, error) {
+	inventories := make([]NodeInventory, 0)
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, ManagedDeviceURI, nil, &inventories)
+	if err != nil {
+		return nil, err
+	}
+	return inventories, nil
+}
+
+// GetNodeInventoryTargets returns the inventory of the given nodes, which are selected by reference ID, service tag or IP address
+func GetNodeInventoryTargets(inventories []NodeInventory, nodes []string) ([]NodeInventory, error) {
+	targets := make([]NodeInventory, 0, len(nodes))
+	for _, node := range nodes {
+		found := false
+		for _, inventory := range inventories {
+			if inventory.RefID == node || strings.EqualFold(inventory.ServiceTag, node) || inventory.IPAddress == node {
+				targets = append(targets, inventory)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("node %s is not found in the inventory", node)
+		}
+	}
+	return targets, nil
+}
+
+// IsNodeInventoryInProgress returns true if the node is still being inventoried
+func IsNodeInventoryInProgress(state string) bool {
+	switch strings.ToUpper(state) {
+	case "PENDING", "UPDATING", "INVENTORYING", "DISCOVERING":
+		return true
+	}
+	return false
+}
+
+// ParseNodeLastRefreshDate returns the date of the last inventory of the node, or an error if PowerFlex Manager does not return a valid date
+func ParseNodeLastRefreshDate(inventory NodeInventory) (time.Time, error) {
+	if inventory.LastRefreshDate() == "" {
+		return time.Time{}, fmt.Errorf("inventory date of node %s is not returned by PowerFlex Manager, its last refresh date is unknown", inventory.RefID)
+	}
+	date, err := time.Parse(time.RFC3339Nano, inventory.LastRefreshDate())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("inventory date %s of node %s is not valid: %s", inventory.LastRefreshDate(), inventory.RefID, err.Error())
+	}
+	return date, nil
+}
+
+// IsNodeInventoryStale returns true if the node has not been inventoried since the given time.
+// A node whose last refresh date cannot be parsed is considered stale.
+func IsNodeInventoryStale(lastRefreshDate string, since time.Time) bool {
+	date, err := time.Parse(time.RFC3339Nano, lastRefreshDate)
+	if err != nil {
+		return true
+	}
+	return date.Before(since)
+}
+
+// StartNodeInventoryRefresh refreshes the inventory and health of the given devices
+func StartNodeInventoryRefresh(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, refIDs []string) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPost, NodeInventoryRefreshURI, NodeInventoryRefreshRequest{RefIDs: refIDs}, nil)
+}
+
+// WaitForNodeInventoryRefresh waits until the given devices have been inventoried since the given time or the timeout expires
+func WaitForNodeInventoryRefresh(ctx context.Context, gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, refIDs []string, since time.Time, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	// a refresh which is completed shortly before the request is accepted, to tolerate the clock skew with PowerFlex Manager
+	since = since.Add(-time.Minute)
+	for {
+		inventories, err := GetNodeInventories(gatewayClient, client)
+		if err != nil {
+			return err
+		}
+		pending := make([]string, 0)
+		for _, inventory := range inventories {
+			for _, refID := range refIDs {
+				if inventory.RefID != refID {
+					continue
+				}
+				// a node which was never inventoried has no inventory date until its first refresh is completed
+				if IsNodeInventoryInProgress(inventory.State) || inventory.LastRefreshDate() == "" {
+					pending = append(pending, refID)
+					continue
+				}
+				date, err := ParseNodeLastRefreshDate(inventory)
+				if err != nil {
+					return err
+				}
+				if date.Before(since) {
+					pending = append(pending, refID)
+				}
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("inventory of nodes %s is not refreshed after %s", strings.Join(pending, ", "), timeout)
+		}
+		tflog.Info(ctx, "Inventory refresh is in progress", map[string]interface{}{"nodes": pending})
+		time.Sleep(NodeInventoryPollInterval)
+	}
+}
+
+// UpdateNodeInventoryRefreshState updates the state of the node inventory refresh resource with the inventory of its nodes
+func UpdateNodeInventoryRefreshState(ctx context.Context, plan models.NodeInventoryRefreshResourceModel, targets []NodeInventory) (models.NodeInventoryRefreshResourceModel, diag.Diagnostics) {
+	state := plan
+	details := make([]models.NodeInventoryModel, 0, len(targets))
+	refIDs := make([]string, 0, len(targets))
+	for _, target := range targets {
+		details = append(details, models.NodeInventoryModel{
+			RefID:           types.StringValue(target.RefID),
+			ServiceTag:      types.StringValue(target.ServiceTag),
+			IPAddress:       types.StringValue(target.IPAddress),
+			State:           types.StringValue(target.State),
+			Health:          types.StringValue(target.Health),
+			HealthMessage:   types.StringValue(target.HealthMessage),
+			LastRefreshDate: types.StringValue(target.LastRefreshDate()),
+		})
+		refIDs = append(refIDs, target.RefID)
+	}
+	state.ID = types.StringValue(strings.Join(refIDs, ","))
+
+	var diags diag.Diagnostics
+	state.NodeDetails, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: NodeInventoryAttrTypes}, details)
+	return state, diags
+}
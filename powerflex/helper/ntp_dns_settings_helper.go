@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	// ManagerTimeSettingsURI is the PowerFlex Manager URI of the NTP, DNS and timezone settings
+	ManagerTimeSettingsURI = "/Api/V1/ApplianceSettings/TimeAndDns"
+	// NodeTimeSettingsQueryURI is the gateway URI to query the NTP, DNS and timezone settings of the nodes through LIA
+	NodeTimeSettingsQueryURI = "/im/types/Lia/instances/actions/queryTimeSettings"
+	// NodeTimeSettingsSetURI is the gateway URI to set the NTP, DNS and timezone settings of the nodes through LIA
+	NodeTimeSettingsSetURI = "/im/types/Lia/instances/actions/setTimeSettings"
+)
+
+// TimeSettings defines the NTP, DNS and timezone settings of PowerFlex Manager or of a node
+type TimeSettings struct {
+	IP         string   `json:"ip,omitempty"`
+	NtpServers []string `json:"ntpServers,omitempty"`
+	DNSServers []string `json:"dnsServers,omitempty"`
+	Timezone   string   `json:"timezone,omitempty"`
+}
+
+// NodeTimeSettingsParam defines the payload to query or set the settings of the nodes through LIA
+type NodeTimeSettingsParam struct {
+	MdmIPs      []string      `json:"mdmIps"`
+	MdmPassword string        `json:"mdmPassword"`
+	LiaPassword string        `json:"liaPassword"`
+	NodeIPs     []string      `json:"nodeIps"`
+	Settings    *TimeSettings `json:"settings,omitempty"`
+}
+
+// GetManagerTimeSettings returns the NTP, DNS and timezone settings of PowerFlex Manager
+func GetManagerTimeSettings(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) (*TimeSettings, error) {
+	settings := &TimeSettings{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, ManagerTimeSettingsURI, nil, settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SetManagerTimeSettings sets the NTP, DNS and timezone settings of PowerFlex Manager
+func SetManagerTimeSettings(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, settings *TimeSettings) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPut, ManagerTimeSettingsURI, settings, nil)
+}
+
+// GetNodeTimeSettings returns the NTP, DNS and timezone settings of the cluster nodes through LIA
+func GetNodeTimeSettings(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, param *NodeTimeSettingsParam) ([]TimeSettings, error) {
+	var settings []TimeSettings
+	err := DoGatewayRequest(gatewayClient, client, http.MethodPost, NodeTimeSettingsQueryURI, param, &settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// SetNodeTimeSettings sets the NTP, DNS and timezone settings of the cluster nodes through LIA
+func SetNodeTimeSettings(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, param *NodeTimeSettingsParam) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPost, NodeTimeSettingsSetURI, param, nil)
+}
+
+// GetTimeSettingsPayload builds the settings from the plan, the attributes which are not known are not changed
+func GetTimeSettingsPayload(ctx context.Context, plan models.NtpDNSSettingsResourceModel) (*TimeSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings := &TimeSettings{}
+	if Known(plan.NtpServers) {
+		diags.Append(plan.NtpServers.ElementsAs(ctx, &settings.NtpServers, false)...)
+	}
+	if Known(plan.DNSServers) {
+		diags.Append(plan.DNSServers.ElementsAs(ctx, &settings.DNSServers, false)...)
+	}
+	if Known(plan.Timezone) {
+		settings.Timezone = plan.Timezone.ValueString()
+	}
+	return settings, diags
+}
+
+// GetNodeTimeSettingsParam builds the payload for the cluster nodes, it returns nil if the cluster nodes are not configured
+func GetNodeTimeSettingsParam(ctx context.Context, plan models.NtpDNSSettingsResourceModel) (*NodeTimeSettingsParam, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !Known(plan.ClusterNodes) {
+		return nil, diags
+	}
+	var nodes models.NtpDNSClusterNodesModel
+	diags.Append(plan.ClusterNodes.As(ctx, &nodes, basetypes.ObjectAsOptions{})...)
+	param := &NodeTimeSettingsParam{
+		MdmIPs:      []string{nodes.MdmIP.ValueString()},
+		MdmPassword: nodes.MdmPassword.ValueString(),
+		LiaPassword: nodes.LiaPassword.ValueString(),
+	}
+	diags.Append(nodes.NodeIPs.ElementsAs(ctx, &param.NodeIPs, false)...)
+	return param, diags
+}
+
+// GetOutOfSyncNodes returns the IPs of the nodes whose settings differ from the expected settings.
+// NTP and DNS servers are compared as sets, the servers which are not configured are not compared.
+func GetOutOfSyncNodes(expected *TimeSettings, nodes []TimeSettings) []string {
+	outOfSync := make([]string, 0)
+	for _, node := range nodes {
+		if (len(expected.NtpServers) > 0 && !SameServers(expected.NtpServers, node.NtpServers)) ||
+			(len(expected.DNSServers) > 0 && !SameServers(expected.DNSServers, node.DNSServers)) ||
+			(expected.Timezone != "" && expected.Timezone != node.Timezone) {
+			outOfSync = append(outOfSync, node.IP)
+		}
+	}
+	sort.Strings(outOfSync)
+	return outOfSync
+}
+
+// SameServers returns true if both lists hold the same servers, regardless of order, case and surrounding spaces
+func SameServers(a, b []string) bool {
+	normalize := func(servers []string) []string {
+		normalized := make([]string, 0, len(servers))
+		for _, server := range servers {
+			normalized = append(normalized, strings.ToLower(strings.TrimSpace(server)))
+		}
+		return normalized
+	}
+	return CompareStringSlice(normalize(a), normalize(b))
+}
+
+// serversValue returns the servers of PowerFlex Manager, keeping the planned list if it holds the same servers
+func serversValue(planned types.List, servers []string) (types.List, diag.Diagnostics) {
+	if Known(planned) {
+		var plannedServers []string
+		diags := planned.ElementsAs(context.Background(), &plannedServers, false)
+		if !diags.HasError() && SameServers(plannedServers, servers) {
+			return planned, diags
+		}
+	}
+	if servers == nil {
+		servers = []string{}
+	}
+	return types.ListValueFrom(context.Background(), types.StringType, servers)
+}
+
+// UpdateNtpDNSSettingsState maps the settings of PowerFlex Manager and the out of sync nodes to the state
+func UpdateNtpDNSSettingsState(plan models.NtpDNSSettingsResourceModel, settings *TimeSettings, outOfSyncNodes []string) (models.NtpDNSSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var dgs diag.Diagnostics
+	state := plan
+	state.ID = types.StringValue("ntp_dns_settings")
+
+	state.NtpServers, dgs = serversValue(plan.NtpServers, settings.NtpServers)
+	diags.Append(dgs...)
+	state.DNSServers, dgs = serversValue(plan.DNSServers, settings.DNSServers)
+	diags.Append(dgs...)
+	state.Timezone = types.StringValue(settings.Timezone)
+	state.OutOfSyncNodes, dgs = types.ListValueFrom(context.Background(), types.StringType, outOfSyncNodes)
+	diags.Append(dgs...)
+	if len(outOfSyncNodes) > 0 {
+		diags.AddWarning(
+			"NTP, DNS or timezone settings of cluster nodes have drifted",
+			fmt.Sprintf("Settings of the nodes %v differ from PowerFlex Manager, they will be updated on the next apply", outOfSyncNodes),
+		)
+	}
+	return state, diags
+}
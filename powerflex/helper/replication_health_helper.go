@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"net/http"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ReplicationConsistencyGroupStatistics defines the statistics of a replication consistency group
+type ReplicationConsistencyGroupStatistics struct {
+	CurrentLagInSeconds int64 `json:"rplCurrentLagInSec"`
+}
+
+// ProtectionDomainJournalStatistics defines the replication journal statistics and the capacity of a protection domain in KB
+type ProtectionDomainJournalStatistics struct {
+	TotalJournalCapacity int64 `json:"rplTotalJournalCap"`
+	UsedJournalCapacity  int64 `json:"rplUsedJournalCap"`
+	MaxCapacity          int64 `json:"maxCapacityInKb"`
+}
+
+// ProtectionDomainJournal defines the replication journal capacity and usage of a protection domain
+type ProtectionDomainJournal struct {
+	CapacityMaxRatio int64
+	Statistics       ProtectionDomainJournalStatistics
+}
+
+// GetRCGStatistics returns the statistics of a replication consistency group
+func GetRCGStatistics(client *goscaleio.Client, id string) (*ReplicationConsistencyGroupStatistics, error) {
+	stats := &ReplicationConsistencyGroupStatistics{}
+	err := DoRestRequest(client, http.MethodGet, "/api/instances/ReplicationConsistencyGroup::"+id+"/relationships/Statistics", nil, stats)
+	return stats, err
+}
+
+// GetProtectionDomainJournal returns the replication journal capacity and usage of a protection domain
+func GetProtectionDomainJournal(client *goscaleio.Client, id string) (*ProtectionDomainJournal, error) {
+	pd := &scaleiotypes.ProtectionDomain{}
+	err := DoRestRequest(client, http.MethodGet, "/api/instances/ProtectionDomain::"+id, nil, pd)
+	if err != nil {
+		return nil, err
+	}
+	journal := &ProtectionDomainJournal{}
+	if pd.ReplicationCapacityMaxRatio != nil {
+		journal.CapacityMaxRatio = int64(*pd.ReplicationCapacityMaxRatio)
+	}
+	err = DoRestRequest(client, http.MethodGet, "/api/instances/ProtectionDomain::"+id+"/relationships/Statistics", nil, &journal.Statistics)
+	return journal, err
+}
+
+// GetReplicationPairProgress returns the initial copy progress of a replication pair
+func GetReplicationPairProgress(client *goscaleio.Client, id string) (float64, error) {
+	pair := goscaleio.NewReplicationPair(client)
+	pair.ReplicaitonPair.ID = id
+	stats, err := pair.GetReplicationPairStatistics()
+	if err != nil {
+		return 0, err
+	}
+	return stats.InitialCopyProgress, nil
+}
+
+// GetReplicationHealth returns the health and RPO compliance of a replication consistency group.
+// The journals of the protection domains are cached in journals as several RCGs can share a protection domain.
+func GetReplicationHealth(client *goscaleio.Client, rcg scaleiotypes.ReplicationConsistencyGroup, journals map[string]*ProtectionDomainJournal) (*models.ReplicationHealthModel, error) {
+	stats, err := GetRCGStatistics(client, rcg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get statistics of replication consistency group %s: %s", rcg.Name, err.Error())
+	}
+	journal, ok := journals[rcg.ProtectionDomainID]
+	if !ok {
+		journal, err = GetProtectionDomainJournal(client, rcg.ProtectionDomainID)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get journal of protection domain %s: %s", rcg.ProtectionDomainID, err.Error())
+		}
+		journals[rcg.ProtectionDomainID] = journal
+	}
+	pairs, err := GetRCGReplicationPairs(client, rcg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get replication pairs of replication consistency group %s: %s", rcg.Name, err.Error())
+	}
+
+	health := &models.ReplicationHealthModel{
+		ID:                      types.StringValue(rcg.ID),
+		Name:                    types.StringValue(rcg.Name),
+		RpoInSeconds:            types.Int64Value(int64(rcg.RpoInSeconds)),
+		LagInSeconds:            types.Int64Value(stats.CurrentLagInSeconds),
+		AbstractState:           types.StringValue(rcg.AbstractState),
+		Error:                   types.Int64Value(int64(rcg.Error)),
+		InactiveReason:          types.Int64Value(int64(rcg.InactiveReason)),
+		ProtectionDomainID:      types.StringValue(rcg.ProtectionDomainID),
+		JournalCapacityMaxRatio: types.Int64Value(journal.CapacityMaxRatio),
+		JournalCapacityInKb:     types.Int64Value(journal.Statistics.TotalJournalCapacity),
+		JournalUsedInKb:         types.Int64Value(journal.Statistics.UsedJournalCapacity),
+		ReplicationPairs:        []models.ReplicationHealthPairModel{},
+	}
+
+	initialCopyComplete := true
+	for _, pair := range pairs {
+		progress := 100.0
+		if pair.InitialCopyState != "Done" {
+			initialCopyComplete = false
+			progress, err = GetReplicationPairProgress(client, pair.ID)
+			if err != nil {
+				return nil, fmt.Errorf("Could not get initial copy progress of replication pair %s: %s", pair.Name, err.Error())
+			}
+		}
+		health.ReplicationPairs = append(health.ReplicationPairs, models.ReplicationHealthPairModel{
+			ID:                  types.StringValue(pair.ID),
+			Name:                types.StringValue(pair.Name),
+			InitialCopyState:    types.StringValue(pair.InitialCopyState),
+			InitialCopyProgress: types.Float64Value(progress),
+		})
+	}
+	health.InitialCopyComplete = types.BoolValue(initialCopyComplete)
+
+	usage := 0.0
+	if journal.Statistics.TotalJournalCapacity > 0 {
+		usage = float64(journal.Statistics.UsedJournalCapacity) * 100 / float64(journal.Statistics.TotalJournalCapacity)
+	}
+	health.JournalUsagePercent = types.Float64Value(usage)
+
+	// the journal may use up to the replication capacity max ratio of the capacity of the protection domain
+	ratio := 0.0
+	if journal.Statistics.MaxCapacity > 0 {
+		ratio = float64(journal.Statistics.UsedJournalCapacity) * 100 / float64(journal.Statistics.MaxCapacity)
+	}
+	health.JournalUsedRatio = types.Float64Value(ratio)
+
+	reasons := GetReplicationNonCompliantReasons(rcg, stats.CurrentLagInSeconds, initialCopyComplete, ratio, journal.CapacityMaxRatio)
+	health.NonCompliantReasons = []types.String{}
+	for _, reason := range reasons {
+		health.NonCompliantReasons = append(health.NonCompliantReasons, types.StringValue(reason))
+	}
+	health.Compliant = types.BoolValue(len(reasons) == 0)
+	return health, nil
+}
+
+// GetReplicationNonCompliantReasons returns the reasons for which a replication consistency group does not comply with its RPO
+func GetReplicationNonCompliantReasons(rcg scaleiotypes.ReplicationConsistencyGroup, lag int64, initialCopyComplete bool, journalUsedRatio float64, capacityMaxRatio int64) []string {
+	reasons := []string{}
+	if lag > int64(rcg.RpoInSeconds) {
+		reasons = append(reasons, fmt.Sprintf("lag of %d seconds exceeds the RPO of %d seconds", lag, rcg.RpoInSeconds))
+	}
+	if rcg.Error != 0 {
+		reasons = append(reasons, fmt.Sprintf("replication consistency group is in error %d", rcg.Error))
+	}
+	if rcg.InactiveReason != 0 {
+		reasons = append(reasons, fmt.Sprintf("replication consistency group is inactive with reason %d", rcg.InactiveReason))
+	}
+	if !initialCopyComplete {
+		reasons = append(reasons, "initial copy of the replication pairs is not complete")
+	}
+	if capacityMaxRatio > 0 && journalUsedRatio >= float64(capacityMaxRatio) {
+		reasons = append(reasons, fmt.Sprintf("journal usage of %.2f%% of the protection domain capacity reaches the replication capacity max ratio of %d%%", journalUsedRatio, capacityMaxRatio))
+	}
+	return reasons
+}
@@ -19,12 +19,16 @@ package helper
 
 import (
 	"context"
+	"encoding/pem"
 	"fmt"
+	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
 	sshClient "terraform-provider-powerflex/client"
 	"terraform-provider-powerflex/powerflex/constants"
 	"terraform-provider-powerflex/powerflex/models"
+	"time"
 
 	"github.com/dell/goscaleio"
 
@@ -32,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // GetPeerSystem GET peer system
@@ -42,20 +47,23 @@ func GetPeerSystem(client *goscaleio.Client, peerSystemID string) (*scaleiotypes
 // MapPeerSystemResourceState map peer system state
 func MapPeerSystemResourceState(peerSystem scaleiotypes.PeerMDM, state models.PeerMdmResourceModel) models.PeerMdmResourceModel {
 	return models.PeerMdmResourceModel{
-		ID:                        types.StringValue(peerSystem.ID),
-		Name:                      state.Name,
-		Port:                      state.Port,
-		PeerSystemID:              state.PeerSystemID,
-		SystemID:                  types.StringValue(peerSystem.SystemID),
-		SoftwareVersionInfo:       types.StringValue(peerSystem.SoftwareVersionInfo),
-		MembershipState:           types.StringValue(peerSystem.MembershipState),
-		PerfProfile:               state.PerfProfile,
-		NetworkType:               types.StringValue(peerSystem.NetworkType),
-		CouplingRC:                types.StringValue(peerSystem.CouplingRC),
-		IPList:                    state.IPList,
-		AddCertificate:            state.AddCertificate,
-		DestinationPrimaryMdmInfo: fillInPrimaryMdmInfo(state.DestinationPrimaryMdmInfo),
-		SourcePrimaryMdmInfo:      fillInPrimaryMdmInfo(state.SourcePrimaryMdmInfo),
+		ID:                         types.StringValue(peerSystem.ID),
+		Name:                       state.Name,
+		Port:                       state.Port,
+		PeerSystemID:               state.PeerSystemID,
+		SystemID:                   types.StringValue(peerSystem.SystemID),
+		SoftwareVersionInfo:        types.StringValue(peerSystem.SoftwareVersionInfo),
+		MembershipState:            types.StringValue(peerSystem.MembershipState),
+		PerfProfile:                state.PerfProfile,
+		NetworkType:                types.StringValue(peerSystem.NetworkType),
+		CouplingRC:                 types.StringValue(peerSystem.CouplingRC),
+		IPList:                     state.IPList,
+		AddCertificate:             state.AddCertificate,
+		CertificateExchangeMethod:  state.CertificateExchangeMethod,
+		DestinationRootCertificate: state.DestinationRootCertificate,
+		ManagementInsecure:         state.ManagementInsecure,
+		DestinationPrimaryMdmInfo:  fillInPrimaryMdmInfo(state.DestinationPrimaryMdmInfo),
+		SourcePrimaryMdmInfo:       fillInPrimaryMdmInfo(state.SourcePrimaryMdmInfo),
 	}
 }
 
@@ -247,6 +255,87 @@ func AddCertificate(ctx context.Context, client *goscaleio.Client, plan models.P
 	return nil
 }
 
+// Certificate exchange methods supported by the peer system resource
+const (
+	CertificateExchangeSSH = "SSH"
+	CertificateExchangeAPI = "API"
+	CertificateExchangePEM = "PEM"
+)
+
+// RootCertificate holds the PEM encoded root certificate returned by the management api
+type RootCertificate struct {
+	Certificate string `json:"certificate"`
+}
+
+// ExchangeCertificate adds the root certificate of the peer mdm destination to the source mdm trust store using the configured method
+func ExchangeCertificate(ctx context.Context, client *goscaleio.Client, plan models.PeerMdmResourceModel) error {
+	switch plan.CertificateExchangeMethod.ValueString() {
+	case CertificateExchangeAPI:
+		return AddCertificateUsingAPI(ctx, client, plan)
+	case CertificateExchangePEM:
+		if plan.DestinationRootCertificate.ValueString() == "" {
+			return fmt.Errorf("destination_root_certificate is required when certificate_exchange_method is %s", CertificateExchangePEM)
+		}
+		return AddTrustedCertificate(client, plan.DestinationRootCertificate.ValueString(), "Adding Peer Mdm "+plan.PeerSystemID.ValueString()+" root certificate")
+	default:
+		return AddCertificate(ctx, client, plan)
+	}
+}
+
+// AddCertificateUsingAPI gets the root certificate from the destination management instance over REST and adds it to the source mdm trust store
+func AddCertificateUsingAPI(ctx context.Context, client *goscaleio.Client, plan models.PeerMdmResourceModel) error {
+	var destination models.PrimaryMdmInfo
+	plan.DestinationPrimaryMdmInfo.As(ctx, &destination, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	if destination.ManagementIP == "" || destination.ManagementUsername == "" || destination.ManagementPassword == nil {
+		return fmt.Errorf("management_ip, management_username and management_password of destination_primary_mdm_information are required when certificate_exchange_method is %s", CertificateExchangeAPI)
+	}
+
+	endpoint := destination.ManagementIP
+	if !strings.HasPrefix(endpoint, "https://") {
+		endpoint = "https://" + endpoint
+	}
+	destClient, err := NewPeerClient(endpoint, destination.ManagementUsername, *destination.ManagementPassword, plan.ManagementInsecure.ValueBool())
+	if err != nil {
+		return err
+	}
+
+	certificate, err := GetRootCertificate(destClient)
+	if err != nil {
+		return fmt.Errorf("Unable to extract root certificate: %s", err.Error())
+	}
+	tflog.Debug(ctx, "Extracted root certificate of the peer mdm destination "+destination.ManagementIP)
+	return AddTrustedCertificate(client, certificate, "Adding Peer Mdm "+destination.ManagementIP+"_root.pem")
+}
+
+// GetRootCertificate extracts the PEM encoded root certificate of a PowerFlex system
+func GetRootCertificate(client *goscaleio.Client) (string, error) {
+	var cert RootCertificate
+	err := DoRestRequest(client, http.MethodPost, "/api/instances/System/action/extractRootCaCertificate", scaleiotypes.EmptyPayload{}, &cert)
+	if err != nil {
+		return "", err
+	}
+	if cert.Certificate == "" {
+		return "", fmt.Errorf("no root certificate was returned by the system")
+	}
+	return cert.Certificate, nil
+}
+
+// AddTrustedCertificate adds a PEM encoded certificate to the trust store of the system, a certificate that is already trusted is not an error
+func AddTrustedCertificate(client *goscaleio.Client, certificate, comment string) error {
+	if block, _ := pem.Decode([]byte(certificate)); block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("the root certificate of the peer mdm destination is not a valid PEM encoded certificate")
+	}
+	payload := map[string]string{
+		"certificate": certificate,
+		"comment":     comment,
+	}
+	err := DoRestRequest(client, http.MethodPost, "/api/instances/System/action/addTrustedCaCertificate", payload, nil)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), "already") {
+		return fmt.Errorf("Unable to add trusted cert: %s", err.Error())
+	}
+	return nil
+}
+
 // GetReplicationPairs GET replication pairs
 func GetReplicationPairs(client *goscaleio.Client) ([]scaleiotypes.ReplicationPair, error) {
 	rps := []scaleiotypes.ReplicationPair{}
@@ -575,9 +664,242 @@ func RCGDoAction(client *goscaleio.Client, actions models.ReplicationConsistency
 	case constants.Switchover:
 		return rcgClient.ExecuteSwitchoverOnReplicationGroup(false)
 	case constants.Snapshot:
-		_, err := rcgClient.CreateReplicationConsistencyGroupSnapshot()
+		_, err := CreateRCGSnapshot(client, rcgClient.ReplicationConsistencyGroup.ID)
 		return err
+	case constants.TestFailover:
+		return DoRestRequest(client, http.MethodPost, "/api/instances/ReplicationConsistencyGroup::"+rcgClient.ReplicationConsistencyGroup.ID+"/action/testFailoverReplicationConsistencyGroup", scaleiotypes.EmptyPayload{}, nil)
+	case constants.TestFailoverStop:
+		return DoRestRequest(client, http.MethodPost, "/api/instances/ReplicationConsistencyGroup::"+rcgClient.ReplicationConsistencyGroup.ID+"/action/testFailoverStopReplicationConsistencyGroup", scaleiotypes.EmptyPayload{}, nil)
 	default:
 		return fmt.Errorf("Invalid Action %s", actions.Action.ValueString())
 	}
 }
+
+// CreateRCGSnapshot creates a snapshot of the volumes of the RCG on the target system and returns the ID of the snapshot group
+func CreateRCGSnapshot(client *goscaleio.Client, id string) (string, error) {
+	rcgClient := goscaleio.NewReplicationConsistencyGroup(client)
+	rcgClient.ReplicationConsistencyGroup.ID = id
+	resp, err := rcgClient.CreateReplicationConsistencyGroupSnapshot()
+	if err != nil {
+		return "", err
+	}
+	return resp.SnapshotGroupID, nil
+}
+
+// GetRCGSnapshots returns the snapshots of the local volumes of the replication pairs of an RCG.
+// If snapshotGroupID is set, only the snapshots of that snapshot group are returned.
+func GetRCGSnapshots(client *goscaleio.Client, rcgID, snapshotGroupID string) ([]models.ReplicationConsistencyGroupSnapshotModel, error) {
+	pairs, err := GetRCGReplicationPairs(client, rcgID)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get replication pairs of replication consistency group %s: %s", rcgID, err.Error())
+	}
+	pairsByVolume := make(map[string]*scaleiotypes.ReplicationPair)
+	for _, pair := range pairs {
+		pairsByVolume[pair.LocalVolumeID] = pair
+	}
+
+	volumes, err := client.GetVolume("", "", "", "", false)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get volumes: %s", err.Error())
+	}
+	volumeNames := make(map[string]string)
+	for _, volume := range volumes {
+		volumeNames[volume.ID] = volume.Name
+	}
+
+	snapshots, err := client.GetVolume("", "", "", "", true)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get snapshots: %s", err.Error())
+	}
+	result := []models.ReplicationConsistencyGroupSnapshotModel{}
+	for _, snapshot := range snapshots {
+		pair, ok := pairsByVolume[snapshot.AncestorVolumeID]
+		if !ok || (snapshotGroupID != "" && snapshot.ConsistencyGroupID != snapshotGroupID) {
+			continue
+		}
+		result = append(result, models.ReplicationConsistencyGroupSnapshotModel{
+			ID:                types.StringValue(snapshot.ID),
+			Name:              types.StringValue(snapshot.Name),
+			SnapshotGroupID:   types.StringValue(snapshot.ConsistencyGroupID),
+			VolumeID:          types.StringValue(pair.LocalVolumeID),
+			VolumeName:        types.StringValue(volumeNames[pair.LocalVolumeID]),
+			SourceVolumeID:    types.StringValue(pair.RemoteVolumeID),
+			SizeInKb:          types.Int64Value(int64(snapshot.SizeInKb)),
+			CreationTime:      types.Int64Value(int64(snapshot.CreationTime)),
+			ReplicationPairID: types.StringValue(pair.ID),
+		})
+	}
+	return result, nil
+}
+
+// GetRCGSnapshotIDs connects to the target system of the RCG and returns the ID of the snapshot of every source volume in the snapshot group
+func GetRCGSnapshotIDs(ctx context.Context, target models.PeerSystemConnectionModel, remoteRCGID, snapshotGroupID string) (types.Map, error) {
+	targetClient, err := NewPeerClient(target.Endpoint.ValueString(), target.Username.ValueString(), target.Password.ValueString(), target.Insecure.ValueBool())
+	if err != nil {
+		return types.MapNull(types.StringType), err
+	}
+	snapshots, err := GetRCGSnapshots(targetClient, remoteRCGID, snapshotGroupID)
+	if err != nil {
+		return types.MapNull(types.StringType), err
+	}
+	ids := make(map[string]string)
+	for _, snapshot := range snapshots {
+		ids[snapshot.SourceVolumeID.ValueString()] = snapshot.ID.ValueString()
+	}
+	val, diags := types.MapValueFrom(ctx, types.StringType, ids)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), fmt.Errorf("Could not map the snapshot IDs")
+	}
+	return val, nil
+}
+
+// RCGActionPollInterval is the interval at which the RCG is polled while waiting for an action to complete
+var RCGActionPollInterval = 10 * time.Second
+
+// IsRCGActionComplete checks whether the RCG has reached the state expected after the action
+func IsRCGActionComplete(action string, rcg *scaleiotypes.ReplicationConsistencyGroup) (bool, error) {
+	switch action {
+	case constants.Failover, constants.Switchover:
+		if rcg.FailoverState == "Failed" {
+			return false, fmt.Errorf("%s failed on replication consistency group %s", action, rcg.ID)
+		}
+		// the failover type of the RCG is set to the action which was done
+		return rcg.FailoverType == action && rcg.FailoverState == "Done", nil
+	case constants.TestFailover:
+		if rcg.FailoverState == "Failed" {
+			return false, fmt.Errorf("%s failed on replication consistency group %s", action, rcg.ID)
+		}
+		return rcg.FailoverState == "Done", nil
+	case constants.Restore, constants.Reverse, constants.TestFailoverStop:
+		// the RCG is back to replicating, in the original direction for restore and in the opposite direction for reverse
+		return rcg.FailoverType == "None" && rcg.FailoverState == "None", nil
+	case constants.Sync:
+		return rcg.CurrConsistMode == "Consistent", nil
+	case constants.Snapshot:
+		return !rcg.SnapCreationInProgress, nil
+	default:
+		return false, fmt.Errorf("Invalid Action %s", action)
+	}
+}
+
+// WaitForRCGAction polls the RCG until the action is complete or the timeout in minutes is reached
+func WaitForRCGAction(ctx context.Context, client *goscaleio.Client, id, action string, timeout int64) (*scaleiotypes.ReplicationConsistencyGroup, error) {
+	endTime := time.Now().Add(time.Duration(timeout) * time.Minute)
+	for {
+		rcg, err := GetSpecificReplicationConsistencyGroup(client, id)
+		if err != nil {
+			return nil, err
+		}
+		done, err := IsRCGActionComplete(action, rcg)
+		if err != nil {
+			return rcg, err
+		}
+		if done {
+			return rcg, nil
+		}
+		if time.Now().After(endTime) {
+			return rcg, fmt.Errorf("timed out waiting for %s to complete, failover state is %s and consistency mode is %s", action, rcg.FailoverState, rcg.CurrConsistMode)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for %s to complete, failover state is %s", action, rcg.FailoverState))
+		time.Sleep(RCGActionPollInterval)
+	}
+}
+
+// UpdateRCGActionState updates the state of the RCG action with the states of the RCG
+func UpdateRCGActionState(plan models.ReplicationConsistencyGroupAction, rcg *scaleiotypes.ReplicationConsistencyGroup) models.ReplicationConsistencyGroupAction {
+	plan.FailoverType = types.StringValue(rcg.FailoverType)
+	plan.FailoverState = types.StringValue(rcg.FailoverState)
+	plan.DisasterRecoveryState = types.StringValue(rcg.DisasterRecoveryState)
+	plan.RemoteDisasterRecoveryState = types.StringValue(rcg.RemoteDisasterRecoveryState)
+	plan.CurrConsistMode = types.StringValue(rcg.CurrConsistMode)
+	plan.AbstractState = types.StringValue(rcg.AbstractState)
+	return plan
+}
+
+// SourceVolumeNamePlaceholder is replaced by the name of the source volume in the name pattern of the destination volume
+const SourceVolumeNamePlaceholder = "{source_volume_name}"
+
+// GetPeerClient returns an authenticated client for the peer system
+func GetPeerClient(dest models.ReplicationPairDestinationVolumeModel) (*goscaleio.Client, error) {
+	return NewPeerClient(dest.Endpoint.ValueString(), dest.Username.ValueString(), dest.Password.ValueString(), dest.Insecure.ValueBool())
+}
+
+// NewPeerClient returns an authenticated client for the peer system
+func NewPeerClient(endpoint, username, password string, insecure bool) (*goscaleio.Client, error) {
+	peerClient, err := goscaleio.NewClientWithArgs(endpoint, "", 120, insecure, true)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create client for the peer system: %s", err.Error())
+	}
+	_, err = peerClient.Authenticate(&goscaleio.ConfigConnect{
+		Endpoint: endpoint,
+		Username: username,
+		Password: password,
+		Insecure: insecure,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to authenticate with the peer system: %s", err.Error())
+	}
+	return peerClient, nil
+}
+
+// GetDestinationVolumeName returns the name of the destination volume from the name pattern
+func GetDestinationVolumeName(pattern, sourceVolumeName string) string {
+	return strings.ReplaceAll(pattern, SourceVolumeNamePlaceholder, sourceVolumeName)
+}
+
+// ProvisionDestinationVolume creates a volume of the same size as the source volume on the peer system and returns its ID.
+// An existing volume with the same name is not reused, since it may be removed on destroy.
+func ProvisionDestinationVolume(ctx context.Context, client, peerClient *goscaleio.Client, sourceVolumeID string, dest models.ReplicationPairDestinationVolumeModel) (string, error) {
+	sourceVolumes, err := client.GetVolume("", sourceVolumeID, "", "", false)
+	if err != nil {
+		return "", fmt.Errorf("Could not get source volume %s: %s", sourceVolumeID, err.Error())
+	}
+	source := sourceVolumes[0]
+	name := GetDestinationVolumeName(dest.NamePattern.ValueString(), source.Name)
+
+	existing, err := peerClient.GetVolume("", "", "", name, false)
+	if err == nil && len(existing) > 0 {
+		return "", fmt.Errorf("volume %s already exists on the peer system with ID %s, please use destination_volume_id to pair with an existing volume", name, existing[0].ID)
+	}
+
+	peerSystem, err := GetFirstSystem(peerClient)
+	if err != nil {
+		return "", err
+	}
+	pd, err := peerSystem.FindProtectionDomain("", dest.ProtectionDomainName.ValueString(), "")
+	if err != nil {
+		return "", fmt.Errorf("Could not get protection domain %s on the peer system: %s", dest.ProtectionDomainName.ValueString(), err.Error())
+	}
+	pdr := goscaleio.NewProtectionDomain(peerClient)
+	pdr.ProtectionDomain = pd
+	sp, err := pdr.FindStoragePool("", dest.StoragePoolName.ValueString(), "")
+	if err != nil {
+		return "", fmt.Errorf("Could not get storage pool %s on the peer system: %s", dest.StoragePoolName.ValueString(), err.Error())
+	}
+	spr := goscaleio.NewStoragePool(peerClient)
+	spr.StoragePool = sp
+
+	res, err := spr.CreateVolume(&scaleiotypes.VolumeParam{
+		ProtectionDomainID: pd.ID,
+		StoragePoolID:      sp.ID,
+		VolumeType:         dest.VolumeType.ValueString(),
+		VolumeSizeInKb:     strconv.Itoa(source.SizeInKb),
+		Name:               name,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Could not create volume %s on the peer system: %s", name, err.Error())
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created volume %s on the peer system as destination volume", name))
+	return res.ID, nil
+}
+
+// RemoveDestinationVolume removes the destination volume from the peer system
+func RemoveDestinationVolume(peerClient *goscaleio.Client, volumeID string) error {
+	volumes, err := peerClient.GetVolume("", volumeID, "", "", false)
+	if err != nil {
+		return err
+	}
+	vr := goscaleio.NewVolume(peerClient)
+	vr.Volume = volumes[0]
+	return vr.RemoveVolume("ONLY_ME")
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"math"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StoragePoolCapacity defines the capacity and configured journal capacity of a storage pool
+type StoragePoolCapacity struct {
+	ID                      string
+	Name                    string
+	CapacityInKb            int64
+	JournalCapacityMaxRatio int64
+}
+
+// GetStoragePoolCapacities returns the capacity of all the storage pools of a protection domain
+func GetStoragePoolCapacities(client *goscaleio.Client, pdID, pdName string) ([]StoragePoolCapacity, error) {
+	pd, err := GetNewProtectionDomainEx(client, pdID, pdName, "")
+	if err != nil {
+		return nil, fmt.Errorf("Could not get protection domain: %s", err.Error())
+	}
+	pools, err := pd.GetStoragePool("")
+	if err != nil {
+		return nil, fmt.Errorf("Could not get storage pools of protection domain %s: %s", pd.ProtectionDomain.ID, err.Error())
+	}
+
+	capacities := []StoragePoolCapacity{}
+	for _, pool := range pools {
+		spr, err := GetStoragePoolInstance(client, pool.ID, pd.ProtectionDomain.ID)
+		if err != nil {
+			return nil, fmt.Errorf("Could not get storage pool %s: %s", pool.ID, err.Error())
+		}
+		stats, err := spr.GetStatistics()
+		if err != nil {
+			return nil, fmt.Errorf("Could not get statistics of storage pool %s: %s", pool.ID, err.Error())
+		}
+		capacities = append(capacities, StoragePoolCapacity{
+			ID:                      spr.StoragePool.ID,
+			Name:                    spr.StoragePool.Name,
+			CapacityInKb:            int64(stats.MaxCapacityInKb),
+			JournalCapacityMaxRatio: int64(spr.StoragePool.ReplicationCapacityMaxRatio),
+		})
+	}
+	return capacities, nil
+}
+
+// GetRequiredJournalCapacityInKb returns the journal capacity needed to hold the writes of the given bandwidth for the given duration
+func GetRequiredJournalCapacityInKb(writeBandwidthInMBPerSecond float64, durationInSeconds int64) int64 {
+	return int64(math.Ceil(writeBandwidthInMBPerSecond * 1024 * float64(durationInSeconds)))
+}
+
+// GetJournalCapacityRecommendation spreads the required journal capacity over the storage pools in proportion to their capacity
+// and returns the recommended journal capacity percentage of every storage pool
+func GetJournalCapacityRecommendation(pools []StoragePoolCapacity, requiredInKb int64) []models.ReplicationJournalCapacityPoolModel {
+	var totalInKb int64
+	for _, pool := range pools {
+		totalInKb += pool.CapacityInKb
+	}
+
+	var recommended int64 = 100
+	if totalInKb > 0 {
+		recommended = int64(math.Ceil(float64(requiredInKb) * 100 / float64(totalInKb)))
+	}
+	recommended = max(recommended, 1)
+
+	result := []models.ReplicationJournalCapacityPoolModel{}
+	for _, pool := range pools {
+		result = append(result, models.ReplicationJournalCapacityPoolModel{
+			ID:                             types.StringValue(pool.ID),
+			Name:                           types.StringValue(pool.Name),
+			CapacityInKb:                   types.Int64Value(pool.CapacityInKb),
+			ConfiguredPercentage:           types.Int64Value(pool.JournalCapacityMaxRatio),
+			ConfiguredJournalCapacityInKb:  types.Int64Value(pool.CapacityInKb * pool.JournalCapacityMaxRatio / 100),
+			RecommendedPercentage:          types.Int64Value(min(recommended, 100)),
+			RecommendedJournalCapacityInKb: types.Int64Value(pool.CapacityInKb * min(recommended, 100) / 100),
+			Sufficient:                     types.BoolValue(recommended <= 100 && pool.JournalCapacityMaxRatio >= recommended),
+		})
+	}
+	return result
+}
@@ -0,0 +1,383 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ReplicationPairsPairAttrTypes are the attribute types of a pair of the replication pairs resource
+var ReplicationPairsPairAttrTypes = map[string]attr.Type{
+	"id":                      types.StringType,
+	"name":                    types.StringType,
+	"source_volume_id":        types.StringType,
+	"destination_volume_id":   types.StringType,
+	"destination_volume_name": types.StringType,
+	"initial_copy_state":      types.StringType,
+	"paused":                  types.BoolType,
+}
+
+// GetSelectedSourceVolumes returns the source volumes selected by IDs or by a name regex
+func GetSelectedSourceVolumes(client *goscaleio.Client, ids []string, nameRegex string) ([]*scaleiotypes.Volume, error) {
+	var selected []*scaleiotypes.Volume
+	if nameRegex == "" {
+		for _, id := range ids {
+			vols, err := client.GetVolume("", id, "", "", false)
+			if err != nil {
+				return nil, fmt.Errorf("Could not get source volume %s: %s", id, err.Error())
+			}
+			selected = append(selected, vols[0])
+		}
+		return selected, nil
+	}
+
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid source volume name regex %s: %s", nameRegex, err.Error())
+	}
+	vols, err := client.GetVolume("", "", "", "", false)
+	if err != nil {
+		return nil, fmt.Errorf("Could not get volumes: %s", err.Error())
+	}
+	for _, vol := range vols {
+		if re.MatchString(vol.Name) {
+			selected = append(selected, vol)
+		}
+	}
+	return selected, nil
+}
+
+// GetRCGReplicationPairs returns the replication pairs of an RCG
+func GetRCGReplicationPairs(client *goscaleio.Client, rcgID string) ([]*scaleiotypes.ReplicationPair, error) {
+	rcgClient := goscaleio.NewReplicationConsistencyGroup(client)
+	rcgClient.ReplicationConsistencyGroup.ID = rcgID
+	return rcgClient.GetReplicationPairs()
+}
+
+// RemoveReplicationPair removes a replication pair
+func RemoveReplicationPair(client *goscaleio.Client, id string) error {
+	pair := goscaleio.NewReplicationPair(client)
+	pair.ReplicaitonPair.ID = id
+	_, err := pair.RemoveReplicationPair(false)
+	return err
+}
+
+// GetReplicationPairsSelection returns the source volume IDs and name regex configured in the replication pairs resource
+func GetReplicationPairsSelection(ctx context.Context, plan models.ReplicationPairsResourceModel) ([]string, string, diag.Diagnostics) {
+	var ids []string
+	diags := plan.SourceVolumeIDs.ElementsAs(ctx, &ids, true)
+	return ids, plan.SourceVolumeNameRegex.ValueString(), diags
+}
+
+// GetManagedReplicationPairIDs returns the IDs of the replication pairs recorded in the state
+func GetManagedReplicationPairIDs(ctx context.Context, state models.ReplicationPairsResourceModel) (map[string]bool, diag.Diagnostics) {
+	managed := make(map[string]bool)
+	var pairs []models.ReplicationPairsPairModel
+	diags := state.Pairs.ElementsAs(ctx, &pairs, true)
+	for _, pair := range pairs {
+		managed[pair.ID.ValueString()] = true
+	}
+	return managed, diags
+}
+
+// ReconcileReplicationPairs creates the replication pairs of the selected source volumes which are not yet paired,
+// removes the managed replication pairs whose source volumes are no longer selected and pauses or resumes the initial copy of the pairs.
+// Pairs of the RCG which are not managed are left untouched, and selecting a source volume which has such a pair is an error.
+// It returns the IDs of the managed replication pairs.
+func ReconcileReplicationPairs(ctx context.Context, client *goscaleio.Client, plan models.ReplicationPairsResourceModel, managed map[string]bool) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rcgID := plan.ReplicationConsistencyGroupID.ValueString()
+
+	var dest models.ReplicationPairDestinationVolumeModel
+	diags.Append(plan.DestinationVolume.As(ctx, &dest, basetypes.ObjectAsOptions{})...)
+	ids, nameRegex, dgs := GetReplicationPairsSelection(ctx, plan)
+	diags.Append(dgs...)
+	var paused []string
+	diags.Append(plan.PausedSourceVolumeIDs.ElementsAs(ctx, &paused, true)...)
+	if diags.HasError() {
+		return managed, diags
+	}
+
+	selected, err := GetSelectedSourceVolumes(client, ids, nameRegex)
+	if err != nil {
+		diags.AddError("Error getting the source volumes", err.Error())
+		return managed, diags
+	}
+	pairs, err := GetRCGReplicationPairs(client, rcgID)
+	if err != nil {
+		diags.AddError("Error getting the replication pairs of the replication consistency group", err.Error())
+		return managed, diags
+	}
+
+	selectedIDs := make(map[string]bool)
+	for _, vol := range selected {
+		selectedIDs[vol.ID] = true
+	}
+	bySource := make(map[string]*scaleiotypes.ReplicationPair)
+	for _, pair := range pairs {
+		bySource[pair.LocalVolumeID] = pair
+	}
+
+	result := make(map[string]bool)
+	var peerClient *goscaleio.Client
+	getPeerClient := func() (*goscaleio.Client, error) {
+		if peerClient == nil {
+			peerClient, err = GetPeerClient(dest)
+		}
+		return peerClient, err
+	}
+
+	// remove the managed pairs whose source volumes are no longer selected
+	for _, pair := range pairs {
+		if !managed[pair.ID] || selectedIDs[pair.LocalVolumeID] {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Removing replication pair %s of source volume %s", pair.Name, pair.LocalVolumeID))
+		if err := RemoveReplicationPair(client, pair.ID); err != nil {
+			diags.AddError(fmt.Sprintf("Error removing replication pair %s", pair.Name), err.Error())
+			result[pair.ID] = true
+			continue
+		}
+		if dest.DeleteOnDestroy.ValueBool() {
+			diags.Append(removeDestinationVolumeOfPair(pair, getPeerClient)...)
+		}
+	}
+
+	// create the pairs of the selected source volumes which are not yet paired
+	created := make(map[string]bool)
+	for _, vol := range selected {
+		if pair, ok := bySource[vol.ID]; ok {
+			// only the pairs created by this resource are managed, a pair created by other means is never adopted
+			if managed[pair.ID] {
+				result[pair.ID] = true
+			} else {
+				diags.AddError(
+					fmt.Sprintf("Source volume %s is already paired", vol.Name),
+					fmt.Sprintf("Replication pair %s of source volume %s was not created by this resource, please deselect the source volume or remove the replication pair.", pair.Name, vol.Name),
+				)
+			}
+			continue
+		}
+		peer, err := getPeerClient()
+		if err != nil {
+			diags.AddError("Error connecting to the peer system", err.Error())
+			return result, diags
+		}
+		destID, err := ProvisionDestinationVolume(ctx, client, peer, vol.ID, dest)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error creating destination volume of source volume %s on the peer system", vol.Name), err.Error())
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Creating replication pair of source volume %s", vol.Name))
+		pairID, err := CreateReplicationPair(client, models.ReplicationPairResourceModel{
+			Name:                          types.StringValue(GetDestinationVolumeName(plan.PairNamePattern.ValueString(), vol.Name)),
+			SourceVolumeID:                types.StringValue(vol.ID),
+			DestinationVolumeID:           types.StringValue(destID),
+			ReplicationConsistencyGroupID: types.StringValue(rcgID),
+		})
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error creating replication pair of source volume %s", vol.Name), err.Error())
+			// remove the destination volume created above, so that it is not left behind on the peer system
+			if err := RemoveDestinationVolume(peer, destID); err != nil {
+				diags.AddError(fmt.Sprintf("Error removing destination volume of source volume %s from the peer system", vol.Name), err.Error())
+			}
+			continue
+		}
+		result[pairID] = true
+		created[pairID] = true
+	}
+
+	pausedIDs := make(map[string]bool)
+	for _, id := range paused {
+		pausedIDs[id] = true
+	}
+	pairs, err = GetRCGReplicationPairs(client, rcgID)
+	if err != nil {
+		diags.AddError("Error getting the replication pairs of the replication consistency group", err.Error())
+		return result, diags
+	}
+	for _, pair := range pairs {
+		if !result[pair.ID] || pair.UserRequestedPauseTransmitInitCopy == pausedIDs[pair.LocalVolumeID] {
+			continue
+		}
+		if pausedIDs[pair.LocalVolumeID] && created[pair.ID] {
+			// a newly created pair may not accept the pause right away
+			err = PauseNewReplicationPair(ctx, client, pair.ID)
+		} else if pausedIDs[pair.LocalVolumeID] {
+			_, err = PauseReplicationPair(client, pair.ID)
+		} else {
+			_, err = ResumeReplicationPair(client, pair.ID)
+		}
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error pausing or resuming replication pair %s, only avaiable during initial copy", pair.Name), err.Error())
+		}
+	}
+	return result, diags
+}
+
+// ReplicationPairPausePollInterval is the interval at which the pause of a newly created replication pair is retried
+var ReplicationPairPausePollInterval = 2 * time.Second
+
+// ReplicationPairPauseTimeout is the time for which the pause of a newly created replication pair is retried
+var ReplicationPairPauseTimeout = 1 * time.Minute
+
+// PauseNewReplicationPair pauses the initial copy of a newly created replication pair, retrying until the pair accepts the pause
+func PauseNewReplicationPair(ctx context.Context, client *goscaleio.Client, id string) error {
+	endTime := time.Now().Add(ReplicationPairPauseTimeout)
+	for {
+		_, err := PauseReplicationPair(client, id)
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(endTime) {
+			return err
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Waiting for replication pair %s to accept the pause: %s", id, err.Error()))
+		time.Sleep(ReplicationPairPausePollInterval)
+	}
+}
+
+// RemoveReplicationPairs removes the managed replication pairs and, if requested, their destination volumes
+func RemoveReplicationPairs(ctx context.Context, client *goscaleio.Client, state models.ReplicationPairsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var dest models.ReplicationPairDestinationVolumeModel
+	diags.Append(state.DestinationVolume.As(ctx, &dest, basetypes.ObjectAsOptions{})...)
+	managed, dgs := GetManagedReplicationPairIDs(ctx, state)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return diags
+	}
+
+	pairs, err := GetRCGReplicationPairs(client, state.ReplicationConsistencyGroupID.ValueString())
+	if err != nil {
+		diags.AddError("Error getting the replication pairs of the replication consistency group", err.Error())
+		return diags
+	}
+	var peerClient *goscaleio.Client
+	getPeerClient := func() (*goscaleio.Client, error) {
+		if peerClient == nil {
+			peerClient, err = GetPeerClient(dest)
+		}
+		return peerClient, err
+	}
+	for _, pair := range pairs {
+		if !managed[pair.ID] {
+			continue
+		}
+		if err := RemoveReplicationPair(client, pair.ID); err != nil {
+			diags.AddError(fmt.Sprintf("Error removing replication pair %s", pair.Name), err.Error())
+			continue
+		}
+		if dest.DeleteOnDestroy.ValueBool() {
+			diags.Append(removeDestinationVolumeOfPair(pair, getPeerClient)...)
+		}
+	}
+	return diags
+}
+
+// removeDestinationVolumeOfPair removes the destination volume of a removed replication pair from the peer system
+func removeDestinationVolumeOfPair(pair *scaleiotypes.ReplicationPair, getPeerClient func() (*goscaleio.Client, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+	peer, err := getPeerClient()
+	if err == nil {
+		err = RemoveDestinationVolume(peer, pair.RemoteVolumeID)
+	}
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error removing destination volume %s from the peer system", pair.RemoteVolumeName), err.Error())
+	}
+	return diags
+}
+
+// UpdateReplicationPairsState sets the managed replication pairs in the state
+func UpdateReplicationPairsState(client *goscaleio.Client, plan models.ReplicationPairsResourceModel, managed map[string]bool) (models.ReplicationPairsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	pairs, err := GetRCGReplicationPairs(client, plan.ReplicationConsistencyGroupID.ValueString())
+	if err != nil {
+		diags.AddError("Error getting the replication pairs of the replication consistency group", err.Error())
+		return plan, diags
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].Name < pairs[j].Name
+	})
+
+	pairObjects := []attr.Value{}
+	for _, pair := range pairs {
+		if !managed[pair.ID] {
+			continue
+		}
+		obj, dgs := types.ObjectValue(ReplicationPairsPairAttrTypes, map[string]attr.Value{
+			"id":                      types.StringValue(pair.ID),
+			"name":                    types.StringValue(pair.Name),
+			"source_volume_id":        types.StringValue(pair.LocalVolumeID),
+			"destination_volume_id":   types.StringValue(pair.RemoteVolumeID),
+			"destination_volume_name": types.StringValue(pair.RemoteVolumeName),
+			"initial_copy_state":      types.StringValue(pair.InitialCopyState),
+			"paused":                  types.BoolValue(pair.UserRequestedPauseTransmitInitCopy),
+		})
+		diags.Append(dgs...)
+		pairObjects = append(pairObjects, obj)
+	}
+	pairList, dgs := types.ListValue(types.ObjectType{AttrTypes: ReplicationPairsPairAttrTypes}, pairObjects)
+	diags.Append(dgs...)
+
+	plan.ID = plan.ReplicationConsistencyGroupID
+	plan.Pairs = pairList
+	return plan, diags
+}
+
+// IsReplicationPairsSelectionChanged checks whether the set of selected source volumes differs from the source volumes of the managed pairs
+func IsReplicationPairsSelectionChanged(ctx context.Context, client *goscaleio.Client, plan, state models.ReplicationPairsResourceModel) (bool, diag.Diagnostics) {
+	ids, nameRegex, diags := GetReplicationPairsSelection(ctx, plan)
+	var pairs []models.ReplicationPairsPairModel
+	diags.Append(state.Pairs.ElementsAs(ctx, &pairs, true)...)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	selected, err := GetSelectedSourceVolumes(client, ids, nameRegex)
+	if err != nil {
+		diags.AddError("Error getting the source volumes", err.Error())
+		return false, diags
+	}
+	if len(selected) != len(pairs) {
+		return true, diags
+	}
+	paired := make(map[string]bool)
+	for _, pair := range pairs {
+		paired[pair.SourceVolumeID.ValueString()] = true
+	}
+	for _, vol := range selected {
+		if !paired[vol.ID] {
+			return true, diags
+		}
+	}
+	return false, diags
+}
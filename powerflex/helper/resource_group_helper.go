@@ -67,6 +67,30 @@ func UpdateResourceGroupState(deploymentResponse *scaleiotypes.ServiceResponse,
 		state.ServersManagedState = types.StringValue("unmanaged")
 	}
 
+	state.RemoveNodes = plan.RemoveNodes
+	if state.RemoveNodes.IsNull() || state.RemoveNodes.IsUnknown() {
+		state.RemoveNodes = types.SetNull(types.StringType)
+	}
+
+	state.ReplaceNodes = plan.ReplaceNodes
+	if state.ReplaceNodes.IsNull() || state.ReplaceNodes.IsUnknown() {
+		state.ReplaceNodes = types.ListNull(types.ObjectType{AttrTypes: ResourceGroupNodeReplacementAttrTypes})
+	}
+
+	state.Overrides = plan.Overrides
+	if state.Overrides.IsNull() || state.Overrides.IsUnknown() {
+		state.Overrides = types.ListNull(types.ObjectType{AttrTypes: ResourceGroupOverrideAttrTypes})
+	}
+
+	state.ResetIdrac = types.BoolValue(plan.ResetIdrac.ValueBool())
+	state.DeleteVMs = types.BoolValue(plan.DeleteVMs.ValueBool())
+	state.RetryOnFailure = types.Int64Value(plan.RetryOnFailure.ValueInt64())
+	state.AdoptExisting = types.BoolValue(plan.AdoptExisting.ValueBool())
+
+	deployedNodes, dgs := GetResourceGroupDeployedNodes(deploymentResponse)
+	diags.Append(dgs...)
+	state.DeployedNodes = deployedNodes
+
 	return state, diags
 }
 
@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetResourceGroupDeployment returns the raw deployment of the resource group, so that the unknown fields are preserved on update
+func GetResourceGroupDeployment(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, deploymentID string) (map[string]interface{}, error) {
+	var deployment map[string]interface{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, ResourceGroupDeploymentURI+"/"+deploymentID, nil, &deployment)
+	if err != nil {
+		return nil, err
+	}
+	return deployment, nil
+}
+
+// RetryResourceGroupDeployment retries the failed deployment of the resource group
+func RetryResourceGroupDeployment(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, deploymentID string) (*scaleiotypes.ServiceResponse, error) {
+	deployment, err := GetResourceGroupDeployment(gatewayClient, client, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	deployment["retry"] = true
+
+	response := &scaleiotypes.ServiceResponse{}
+	err = DoGatewayRequest(gatewayClient, client, http.MethodPut, ResourceGroupDeploymentURI+"/"+deploymentID, deployment, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// SetResourceGroupTeardown marks the components of the deployment to tear down.
+// Tearing down the nodes resets their iDRAC configuration and tearing down the VMs deletes them.
+// It returns the number of components marked to tear down.
+func SetResourceGroupTeardown(deployment map[string]interface{}, resetIdrac, deleteVMs bool) int {
+	serviceTemplate, _ := deployment["serviceTemplate"].(map[string]interface{})
+	components, _ := serviceTemplate["components"].([]interface{})
+
+	count := 0
+	for _, item := range components {
+		component, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		teardown := (component["type"] == "SERVER" && resetIdrac) || (component["type"] == "VIRTUALMACHINE" && deleteVMs)
+		component["teardown"] = teardown
+		if teardown {
+			count++
+		}
+	}
+	deployment["teardown"] = count > 0
+	deployment["individualTeardown"] = count > 0
+	deployment["removeService"] = false
+	return count
+}
+
+// TeardownResourceGroup tears down the nodes and VMs of the resource group before it is deleted
+func TeardownResourceGroup(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, deploymentID string, resetIdrac, deleteVMs bool) (*scaleiotypes.ServiceResponse, error) {
+	deployment, err := GetResourceGroupDeployment(gatewayClient, client, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	if SetResourceGroupTeardown(deployment, resetIdrac, deleteVMs) == 0 {
+		return nil, nil
+	}
+
+	response := &scaleiotypes.ServiceResponse{}
+	err = DoGatewayRequest(gatewayClient, client, http.MethodPut, ResourceGroupDeploymentURI+"/"+deploymentID, deployment, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// brownfieldComponentTypes are the types of the components of the existing infrastructure adopted by a resource group
+var brownfieldComponentTypes = map[interface{}]bool{"SERVER": true, "SCALEIO": true, "CLUSTER": true}
+
+// SetResourceGroupBrownfield marks the node and cluster components of the template as existing infrastructure,
+// so that PowerFlex Manager adopts the discovered nodes instead of deploying them.
+// The other components, such as VMs, are deployed as usual.
+func SetResourceGroupBrownfield(template map[string]interface{}) {
+	components, _ := template["components"].([]interface{})
+	for _, item := range components {
+		component, ok := item.(map[string]interface{})
+		if ok && brownfieldComponentTypes[component["type"]] {
+			component["brownfield"] = true
+		}
+	}
+}
+
+// WaitForResourceGroupDeployment waits for the deployment of the resource group and retries it on failure up to retry_on_failure times
+func WaitForResourceGroupDeployment(ctx context.Context, deploymentResponse *scaleiotypes.ServiceResponse, plan models.ResourceGroupResourceModel, gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) (*scaleiotypes.ServiceResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	deploymentID := deploymentResponse.ID
+	retries := plan.RetryOnFailure.ValueInt64()
+
+	for attempt := int64(0); ; attempt++ {
+		response, dgs := HandleResourceGroupDeployment(ctx, deploymentResponse, plan, gatewayClient)
+		if response != nil {
+			return response, diags
+		}
+
+		current, err := gatewayClient.GetServiceDetailsByID(deploymentID, false)
+		if err != nil || current.Status != "error" || attempt >= retries {
+			diags.Append(dgs...)
+			if !diags.HasError() {
+				diags.AddError("Error in deploying ResourceGroup", fmt.Sprintf("deployment of ResourceGroup %s has failed", deploymentID))
+			}
+			return nil, diags
+		}
+
+		tflog.Info(ctx, "Retrying failed deployment of ResourceGroup", map[string]interface{}{"id": deploymentID, "attempt": attempt + 1})
+		deploymentResponse, err = RetryResourceGroupDeployment(gatewayClient, client, deploymentID)
+		if err != nil {
+			diags.AddError("Error in retrying deployment of ResourceGroup", err.Error())
+			return nil, diags
+		}
+		if deploymentResponse.ID == "" {
+			deploymentResponse.ID = deploymentID
+		}
+	}
+}
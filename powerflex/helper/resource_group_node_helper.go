@@ -0,0 +1,314 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceGroupDeploymentURI is the PowerFlex Manager URI of the resource groups
+const ResourceGroupDeploymentURI = "/Api/V1/Deployment"
+
+// ResourceGroupNodeChanges defines the nodes to remove from and to replace in the resource group
+type ResourceGroupNodeChanges struct {
+	// component IDs of the nodes to remove
+	Remove []string
+	// component IDs of the nodes to replace, mapped to the reference IDs of the new nodes
+	Replace map[string]string
+}
+
+// HasChanges returns true if nodes are removed or replaced
+func (c *ResourceGroupNodeChanges) HasChanges() bool {
+	return len(c.Remove) > 0 || len(c.Replace) > 0
+}
+
+// ResourceGroupDeployedNodeAttrTypes defines the attribute types of a node deployed by the resource group
+var ResourceGroupDeployedNodeAttrTypes = map[string]attr.Type{
+	"ref_id":       types.StringType,
+	"service_tag":  types.StringType,
+	"ip_address":   types.StringType,
+	"component_id": types.StringType,
+	"status":       types.StringType,
+}
+
+// ResourceGroupNodeReplacementAttrTypes defines the attribute types of a node replacement of the resource group
+var ResourceGroupNodeReplacementAttrTypes = map[string]attr.Type{
+	"node":   types.StringType,
+	"ref_id": types.StringType,
+}
+
+// GetResourceGroupNodes returns the devices of the resource group which are deployed as nodes
+func GetResourceGroupNodes(deployment *scaleiotypes.ServiceResponse) []scaleiotypes.DeploymentDevice {
+	serverComponents := make(map[string]bool)
+	for _, component := range deployment.ServiceTemplate.Components {
+		if component.Type == "SERVER" {
+			serverComponents[component.ID] = true
+		}
+	}
+
+	nodes := make([]scaleiotypes.DeploymentDevice, 0)
+	for _, device := range deployment.DeploymentDevice {
+		if serverComponents[device.ComponentID] {
+			nodes = append(nodes, device)
+		}
+	}
+	return nodes
+}
+
+// FindResourceGroupNode returns the node of the resource group with the given service tag or IP address
+func FindResourceGroupNode(deployment *scaleiotypes.ServiceResponse, node string) *scaleiotypes.DeploymentDevice {
+	for _, device := range GetResourceGroupNodes(deployment) {
+		if strings.EqualFold(device.ServiceTag, node) || device.IPAddress == node || device.CurrentIPAddress == node {
+			return &device
+		}
+	}
+	return nil
+}
+
+// getResourceGroupNodeRemovals returns the nodes to remove and the replacements of nodes of the resource group model
+func getResourceGroupNodeRemovals(ctx context.Context, model models.ResourceGroupResourceModel) ([]string, []models.ResourceGroupNodeReplacementModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var removeNodes []string
+	if !model.RemoveNodes.IsNull() && !model.RemoveNodes.IsUnknown() {
+		diags.Append(model.RemoveNodes.ElementsAs(ctx, &removeNodes, false)...)
+	}
+	var replacements []models.ResourceGroupNodeReplacementModel
+	if !model.ReplaceNodes.IsNull() && !model.ReplaceNodes.IsUnknown() {
+		diags.Append(model.ReplaceNodes.ElementsAs(ctx, &replacements, false)...)
+	}
+	return removeNodes, replacements, diags
+}
+
+// GetResourceGroupNodeChanges returns the nodes to remove from and to replace in the resource group.
+// A node which is no longer part of the resource group is only accepted if its removal or replacement is recorded in the state by a previous apply.
+func GetResourceGroupNodeChanges(ctx context.Context, deployment *scaleiotypes.ServiceResponse, plan, state models.ResourceGroupResourceModel) (*ResourceGroupNodeChanges, diag.Diagnostics) {
+	changes := &ResourceGroupNodeChanges{Replace: make(map[string]string)}
+
+	removeNodes, replacements, diags := getResourceGroupNodeRemovals(ctx, plan)
+	removedNodes, replacedNodes, dgs := getResourceGroupNodeRemovals(ctx, state)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for _, node := range removeNodes {
+		device := FindResourceGroupNode(deployment, node)
+		if device != nil {
+			changes.Remove = append(changes.Remove, device.ComponentID)
+			continue
+		}
+		removed := false
+		for _, removedNode := range removedNodes {
+			removed = removed || strings.EqualFold(removedNode, node)
+		}
+		if !removed {
+			diags.AddError(
+				"Error removing node of ResourceGroup",
+				fmt.Sprintf("node %s is not part of the ResourceGroup and was not removed by a previous apply, please validate remove_nodes", node),
+			)
+			return nil, diags
+		}
+	}
+
+	for _, replacement := range replacements {
+		node, refID := replacement.Node.ValueString(), replacement.RefID.ValueString()
+		device := FindResourceGroupNode(deployment, node)
+		if device == nil {
+			replaced := false
+			for _, replacedNode := range replacedNodes {
+				replaced = replaced || (strings.EqualFold(replacedNode.Node.ValueString(), node) && replacedNode.RefID.ValueString() == refID)
+			}
+			if !replaced {
+				diags.AddError(
+					"Error replacing node of ResourceGroup",
+					fmt.Sprintf("node %s is not part of the ResourceGroup and was not replaced with %s by a previous apply, please validate replace_nodes", node, refID),
+				)
+				return nil, diags
+			}
+			continue
+		}
+		for _, deployed := range GetResourceGroupNodes(deployment) {
+			if deployed.RefID == refID {
+				diags.AddError(
+					"Error replacing node of ResourceGroup",
+					fmt.Sprintf("node %s cannot be replaced with %s which is already part of the ResourceGroup", node, refID),
+				)
+				return nil, diags
+			}
+		}
+		for _, componentID := range changes.Remove {
+			if componentID == device.ComponentID {
+				diags.AddError(
+					"Error replacing node of ResourceGroup",
+					fmt.Sprintf("node %s cannot be removed and replaced at the same time", node),
+				)
+				return nil, diags
+			}
+		}
+		changes.Replace[device.ComponentID] = refID
+	}
+	return changes, diags
+}
+
+// RecordResourceGroupNodeChanges returns the state recording the removals and replacements of nodes of the plan, once their teardown is accepted.
+// The next apply then ignores the nodes which are no longer part of the resource group, even if the deployment did not complete.
+func RecordResourceGroupNodeChanges(plan, state models.ResourceGroupResourceModel, changes *ResourceGroupNodeChanges) models.ResourceGroupResourceModel {
+	state.RemoveNodes = plan.RemoveNodes
+	state.ReplaceNodes = plan.ReplaceNodes
+	state.Nodes = types.Int64Value(state.Nodes.ValueInt64() - int64(len(changes.Remove)))
+	return state
+}
+
+// UpdateResourceGroupNodes tears down the removed and replaced nodes of the resource group and deploys the replacement nodes.
+// PowerFlex Manager drains the torn down nodes by removing their SDS and migrating their data.
+func UpdateResourceGroupNodes(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, deploymentID string, changes *ResourceGroupNodeChanges) (*scaleiotypes.ServiceResponse, error) {
+	var deployment map[string]interface{}
+	uri := ResourceGroupDeploymentURI + "/" + deploymentID
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, uri, nil, &deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTemplate, ok := deployment["serviceTemplate"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("service template of ResourceGroup %s is not found", deploymentID)
+	}
+	components, ok := serviceTemplate["components"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("components of ResourceGroup %s are not found", deploymentID)
+	}
+
+	removed := make(map[string]bool)
+	for _, componentID := range changes.Remove {
+		removed[componentID] = true
+	}
+
+	found := 0
+	replacements := make([]interface{}, 0)
+	for _, item := range components {
+		component, ok := item.(map[string]interface{})
+		if !ok || component["type"] != "SERVER" {
+			continue
+		}
+		id, _ := component["id"].(string)
+		refID, replaced := changes.Replace[id]
+		if !removed[id] && !replaced {
+			continue
+		}
+		component["teardown"] = true
+		found++
+		if replaced {
+			replacement, err := getReplacementComponent(component, refID)
+			if err != nil {
+				return nil, err
+			}
+			replacements = append(replacements, replacement)
+		}
+	}
+	if found != len(changes.Remove)+len(changes.Replace) {
+		return nil, fmt.Errorf("some of the nodes to remove or replace are not found in ResourceGroup %s", deploymentID)
+	}
+
+	serviceTemplate["components"] = append(components, replacements...)
+	deployment["serviceTemplate"] = serviceTemplate
+	deployment["individualTeardown"] = true
+	deployment["retry"] = true
+	if len(replacements) > 0 {
+		deployment["scaleUp"] = true
+	}
+
+	response := &scaleiotypes.ServiceResponse{}
+	err = DoGatewayRequest(gatewayClient, client, http.MethodPut, uri, deployment, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// getReplacementComponent returns a copy of the node component which is deployed on the given node of the inventory
+func getReplacementComponent(component map[string]interface{}, refID string) (map[string]interface{}, error) {
+	data, err := json.Marshal(component)
+	if err != nil {
+		return nil, err
+	}
+	var replacement map[string]interface{}
+	err = json.Unmarshal(data, &replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.New().String()
+	replacement["id"] = id
+	replacement["name"] = id
+	replacement["teardown"] = false
+	replacement["brownfield"] = false
+	replacement["identifier"] = nil
+	replacement["asmGUID"] = refID
+	replacement["puppetCertName"] = nil
+	replacement["osPuppetCertName"] = nil
+	replacement["managementIpAddress"] = nil
+
+	// the parameters which identify the torn down node are reset, so that they are assigned to the new node
+	resources, _ := replacement["resources"].([]interface{})
+	for _, item := range resources {
+		resource, ok := item.(map[string]interface{})
+		if !ok || resource["id"] != "asm::server" {
+			continue
+		}
+		resource["guid"] = nil
+		parameters, _ := resource["parameters"].([]interface{})
+		for _, param := range parameters {
+			parameter, ok := param.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			parameter["guid"] = nil
+			if parameter["id"] == "os_host_name" {
+				parameter["value"] = nil
+			}
+		}
+	}
+	return replacement, nil
+}
+
+// GetResourceGroupDeployedNodes returns the nodes deployed by the resource group
+func GetResourceGroupDeployedNodes(deployment *scaleiotypes.ServiceResponse) (types.List, diag.Diagnostics) {
+	nodes := make([]models.ResourceGroupDeployedNodeModel, 0)
+	for _, device := range GetResourceGroupNodes(deployment) {
+		nodes = append(nodes, models.ResourceGroupDeployedNodeModel{
+			RefID:       types.StringValue(device.RefID),
+			ServiceTag:  types.StringValue(device.ServiceTag),
+			IPAddress:   types.StringValue(device.IPAddress),
+			ComponentID: types.StringValue(device.ComponentID),
+			Status:      types.StringValue(device.Status),
+		})
+	}
+	return types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: ResourceGroupDeployedNodeAttrTypes}, nodes)
+}
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceGroupOverrideAttrTypes defines the attribute types of a template parameter override of the resource group
+var ResourceGroupOverrideAttrTypes = map[string]attr.Type{
+	"component": types.StringType,
+	"parameter": types.StringType,
+	"value":     types.StringType,
+}
+
+// GetResourceGroupOverrides returns the template parameter overrides of the plan
+func GetResourceGroupOverrides(ctx context.Context, plan models.ResourceGroupResourceModel) ([]models.ResourceGroupOverrideModel, diag.Diagnostics) {
+	var overrides []models.ResourceGroupOverrideModel
+	if plan.Overrides.IsNull() || plan.Overrides.IsUnknown() {
+		return overrides, nil
+	}
+	diags := plan.Overrides.ElementsAs(ctx, &overrides, false)
+	return overrides, diags
+}
+
+// IsResourceGroupOverridesChanged returns true if the overrides of the plan differ from the overrides the ResourceGroup was deployed with.
+// Adding or removing overrides is a change, while overrides which are not known yet are not compared.
+func IsResourceGroupOverridesChanged(state, plan models.ResourceGroupResourceModel) bool {
+	if state.Overrides.IsUnknown() || plan.Overrides.IsUnknown() {
+		return false
+	}
+	if len(state.Overrides.Elements()) == 0 && len(plan.Overrides.Elements()) == 0 {
+		return false
+	}
+	return !state.Overrides.Equal(plan.Overrides)
+}
+
+// ValidateResourceGroupOverrides validates the overrides against the parameter definitions of the template
+func ValidateResourceGroupOverrides(template *scaleiotypes.TemplateDetails, overrides []models.ResourceGroupOverrideModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	seen := make(map[string]bool)
+	for i, override := range overrides {
+		overridePath := path.Root("overrides").AtListIndex(i)
+		if !Known(override.Component, override.Parameter, override.Value) {
+			continue
+		}
+		componentName, parameterID := override.Component.ValueString(), override.Parameter.ValueString()
+
+		key := componentName + "/" + parameterID
+		if seen[key] {
+			diags.AddAttributeError(overridePath, "Invalid override",
+				fmt.Sprintf("parameter %s of component %s is overridden more than once", parameterID, componentName))
+			continue
+		}
+		seen[key] = true
+
+		componentNames := make([]string, 0)
+		var parameter *scaleiotypes.ParametersDetails
+		componentFound := false
+		for _, component := range template.Components {
+			componentNames = append(componentNames, component.Name)
+			if component.Name != componentName {
+				continue
+			}
+			componentFound = true
+			for _, resource := range component.Resources {
+				for j := range resource.Parameters {
+					if resource.Parameters[j].ID == parameterID {
+						parameter = &resource.Parameters[j]
+					}
+				}
+			}
+		}
+
+		if !componentFound {
+			diags.AddAttributeError(overridePath.AtName("component"), "Invalid override",
+				fmt.Sprintf("component %s is not found in template %s, available components are: %s", componentName, template.TemplateName, strings.Join(componentNames, ", ")))
+			continue
+		}
+		if parameter == nil {
+			diags.AddAttributeError(overridePath.AtName("parameter"), "Invalid override",
+				fmt.Sprintf("parameter %s is not found in component %s of template %s", parameterID, componentName, template.TemplateName))
+			continue
+		}
+		if parameter.ReadOnly {
+			diags.AddAttributeError(overridePath.AtName("parameter"), "Invalid override",
+				fmt.Sprintf("parameter %s of component %s is read only", parameterID, componentName))
+			continue
+		}
+		if err := validateTemplateParameterValue(parameter, override.Value.ValueString()); err != nil {
+			diags.AddAttributeError(overridePath.AtName("value"), "Invalid override",
+				fmt.Sprintf("invalid value of parameter %s of component %s: %s", parameterID, componentName, err.Error()))
+		}
+	}
+	return diags
+}
+
+// validateTemplateParameterValue validates the value against the type, options and bounds of the parameter
+func validateTemplateParameterValue(parameter *scaleiotypes.ParametersDetails, value string) error {
+	if len(parameter.Options) > 0 {
+		allowed := make([]string, 0)
+		for _, option := range parameter.Options {
+			if option.Value == value {
+				return nil
+			}
+			allowed = append(allowed, option.Value)
+		}
+		return fmt.Errorf("value must be one of: %s", strings.Join(allowed, ", "))
+	}
+
+	if parameter.MaxLength > 0 && len(value) > parameter.MaxLength {
+		return fmt.Errorf("value must be at most %d characters long", parameter.MaxLength)
+	}
+
+	switch strings.ToUpper(parameter.Type) {
+	case "INTEGER":
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("value must be an integer")
+		}
+		if number < parameter.Min || (parameter.Max > parameter.Min && number > parameter.Max) {
+			return fmt.Errorf("value must be between %d and %d", parameter.Min, parameter.Max)
+		}
+	case "BOOLEAN":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("value must be true or false")
+		}
+	}
+	return nil
+}
+
+// ApplyResourceGroupOverrides sets the overridden values in the parameters of the template
+func ApplyResourceGroupOverrides(template map[string]interface{}, overrides []models.ResourceGroupOverrideModel) error {
+	components, _ := template["components"].([]interface{})
+	for _, override := range overrides {
+		found := false
+		for _, item := range components {
+			component, ok := item.(map[string]interface{})
+			if !ok || component["name"] != override.Component.ValueString() {
+				continue
+			}
+			resources, _ := component["resources"].([]interface{})
+			for _, res := range resources {
+				resource, ok := res.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				parameters, _ := resource["parameters"].([]interface{})
+				for _, param := range parameters {
+					parameter, ok := param.(map[string]interface{})
+					if ok && parameter["id"] == override.Parameter.ValueString() {
+						parameter["value"] = override.Value.ValueString()
+						found = true
+					}
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("parameter %s of component %s is not found in the template", override.Parameter.ValueString(), override.Component.ValueString())
+		}
+	}
+	return nil
+}
+
+// DeployResourceGroupWithOverrides deploys the template with the overridden parameters.
+// When adopt_existing is set, the discovered existing infrastructure is adopted instead of being deployed.
+func DeployResourceGroupWithOverrides(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, plan models.ResourceGroupResourceModel, overrides []models.ResourceGroupOverrideModel) (*scaleiotypes.ServiceResponse, error) {
+	var template map[string]interface{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, TemplateURI+"/"+plan.TemplateID.ValueString()+"?forDeployment=true", nil, &template)
+	if err != nil {
+		return nil, fmt.Errorf("Service Template Not Found: %s", err.Error())
+	}
+
+	serverCount, _ := template["serverCount"].(float64)
+	if plan.Nodes.ValueInt64() > 0 && plan.Nodes.ValueInt64() != int64(serverCount) {
+		return nil, fmt.Errorf("Node count is not matching with Service Template")
+	}
+
+	err = ApplyResourceGroupOverrides(template, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// the existing infrastructure is adopted as is, so the firmware of its nodes is not updated
+	updateServerFirmware := true
+	if plan.AdoptExisting.ValueBool() {
+		SetResourceGroupBrownfield(template)
+		updateServerFirmware = false
+	}
+
+	deployment := map[string]interface{}{
+		"deploymentName":        plan.DeploymentName.ValueString(),
+		"deploymentDescription": plan.DeploymentDescription.ValueString(),
+		"serviceTemplate":       template,
+		"updateServerFirmware":  updateServerFirmware,
+		"firmwareRepositoryId":  plan.FirmwareID.ValueString(),
+		"brownfield":            plan.AdoptExisting.ValueBool(),
+	}
+	response := &scaleiotypes.ServiceResponse{}
+	err = DoGatewayRequest(gatewayClient, client, http.MethodPost, ResourceGroupDeploymentURI, deployment, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
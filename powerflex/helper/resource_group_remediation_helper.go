@@ -0,0 +1,254 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FirmwareUpdateURI is the PowerFlex Manager URI to update the firmware and software of the devices
+const FirmwareUpdateURI = "/Api/V1/UpdateDeviceFirmware"
+
+// RemediationPollInterval is the interval between two checks of the resource group during the remediation
+var RemediationPollInterval = 1 * time.Minute
+
+// ResourceGroupRemediationNodeAttrTypes defines the attribute types of the compliance of a node of the resource group
+var ResourceGroupRemediationNodeAttrTypes = map[string]attr.Type{
+	"service_tag":              types.StringType,
+	"ip_address":               types.StringType,
+	"host_name":                types.StringType,
+	"compliant":                types.BoolType,
+	"non_compliant_components": types.ListType{ElemType: types.StringType},
+}
+
+// FirmwareUpdateRequest defines the request to update the firmware and software of a resource group or of its devices
+type FirmwareUpdateRequest struct {
+	IDList              []string `json:"idList"`
+	UpdateType          string   `json:"updateType"`
+	ExitMaintenanceMode bool     `json:"exitMaintenanceMode"`
+	ScheduleType        string   `json:"scheduleType"`
+	NextReboot          bool     `json:"nextReboot"`
+}
+
+// GetRemediationNodes returns the nodes of the compliance report which are in the scope of the remediation.
+// All the nodes of the report are considered when no node is given.
+func GetRemediationNodes(reports []scaleiotypes.ComplianceReport, nodes []string) ([]scaleiotypes.ComplianceReport, error) {
+	selected := make([]scaleiotypes.ComplianceReport, 0)
+	if len(nodes) == 0 {
+		for _, report := range reports {
+			if report.DeviceType == "" || strings.Contains(strings.ToLower(report.DeviceType), "server") {
+				selected = append(selected, report)
+			}
+		}
+		return selected, nil
+	}
+	for _, node := range nodes {
+		found := false
+		for _, report := range reports {
+			if strings.EqualFold(report.ServiceTag, node) || report.IPAddress == node {
+				selected = append(selected, report)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("node %s is not part of the resource group", node)
+		}
+	}
+	return selected, nil
+}
+
+// GetRemediationTargets returns the non-compliant nodes of the compliance report which are to be remediated
+func GetRemediationTargets(reports []scaleiotypes.ComplianceReport, nodes []string) ([]scaleiotypes.ComplianceReport, error) {
+	selected, err := GetRemediationNodes(reports, nodes)
+	if err != nil {
+		return nil, err
+	}
+	targets := make([]scaleiotypes.ComplianceReport, 0)
+	for _, report := range selected {
+		if report.Compliant {
+			continue
+		}
+		if !report.CanUpdate {
+			return nil, fmt.Errorf("node %s is not compliant but cannot be updated by PowerFlex Manager", report.ServiceTag)
+		}
+		targets = append(targets, report)
+	}
+	return targets, nil
+}
+
+// StartFirmwareUpdate updates the firmware and software of the resource group against its firmware repository.
+// Only the given devices are updated when their IDs are given.
+func StartFirmwareUpdate(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, deploymentID string, refIDs []string, exitMaintenanceMode bool) error {
+	request := FirmwareUpdateRequest{
+		IDList:              []string{deploymentID},
+		UpdateType:          "SERVICE",
+		ExitMaintenanceMode: exitMaintenanceMode,
+		ScheduleType:        "immediate",
+	}
+	if len(refIDs) > 0 {
+		request.IDList = refIDs
+		request.UpdateType = "DEVICE"
+	}
+	return DoGatewayRequest(gatewayClient, client, http.MethodPut, FirmwareUpdateURI, request, nil)
+}
+
+// IsResourceGroupInProgress returns whether an operation is running on the resource group
+func IsResourceGroupInProgress(status string) bool {
+	switch strings.ToLower(status) {
+	case "in_progress", "pending":
+		return true
+	}
+	return false
+}
+
+// IsComplianceReportChanged returns true if the compliance or the current versions of any of the nodes of the compliance report changed
+func IsComplianceReportChanged(before, after []scaleiotypes.ComplianceReport) bool {
+	afterByID := make(map[string]scaleiotypes.ComplianceReport)
+	for _, report := range after {
+		afterByID[report.ID] = report
+	}
+	for _, report := range before {
+		current, ok := afterByID[report.ID]
+		if !ok || current.Compliant != report.Compliant {
+			return true
+		}
+		components := make(map[string]scaleiotypes.ComplianceReportComponents)
+		for _, component := range current.ComplianceReportComponents {
+			components[component.ID] = component
+		}
+		for _, component := range report.ComplianceReportComponents {
+			updated, ok := components[component.ID]
+			if !ok || updated.Compliant != component.Compliant || updated.CurrentVersion.FirmwareVersion != component.CurrentVersion.FirmwareVersion {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WaitForResourceGroupUpdate waits until the update of the nodes of the resource group is completed.
+// The update is completed once the resource group is no longer in progress after it was seen in progress,
+// or once the compliance report of the nodes changed when the update completed between two checks.
+func WaitForResourceGroupUpdate(ctx context.Context, gatewayClient *goscaleio.GatewayClient, deploymentID string, nodes []scaleiotypes.ComplianceReport, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	started := false
+	for {
+		time.Sleep(RemediationPollInterval)
+		deployment, err := gatewayClient.GetServiceDetailsByID(deploymentID, true)
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(deployment.Status, "error") {
+			errorMsg := ""
+			for _, details := range deployment.JobDetails {
+				if details.Level == "error" {
+					errorMsg += details.Message + "\n"
+				}
+			}
+			return fmt.Errorf("update of resource group %s failed: %s", deploymentID, errorMsg)
+		}
+		if IsResourceGroupInProgress(deployment.Status) {
+			started = true
+		} else if started {
+			return nil
+		} else {
+			reports, err := gatewayClient.GetServiceComplianceDetails(deploymentID)
+			if err != nil {
+				return err
+			}
+			if IsComplianceReportChanged(nodes, reports) {
+				return nil
+			}
+		}
+		if time.Now().After(deadline) {
+			if !started {
+				return fmt.Errorf("update of resource group %s did not start after %s", deploymentID, timeout)
+			}
+			return fmt.Errorf("update of resource group %s is not completed after %s", deploymentID, timeout)
+		}
+		tflog.Info(ctx, "Update of the resource group is in progress", map[string]interface{}{"id": deploymentID, "status": deployment.Status, "started": started})
+	}
+}
+
+// IsRemediationRequested returns true if the inputs which remediate the nodes again are changed.
+// A change of the compliance of the nodes alone is not remediated.
+func IsRemediationRequested(plan, state models.ResourceGroupRemediationResourceModel) bool {
+	return !plan.Nodes.Equal(state.Nodes) || !plan.Rolling.Equal(state.Rolling) ||
+		!plan.ExitMaintenanceMode.Equal(state.ExitMaintenanceMode) || !plan.Triggers.Equal(state.Triggers)
+}
+
+// UpdateResourceGroupRemediationState sets the compliance of the remediated nodes in the state
+func UpdateResourceGroupRemediationState(ctx context.Context, plan models.ResourceGroupRemediationResourceModel, reports []scaleiotypes.ComplianceReport) (models.ResourceGroupRemediationResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	state.ID = plan.ResourceGroupID
+
+	var nodes []string
+	if !plan.Nodes.IsNull() && !plan.Nodes.IsUnknown() {
+		diags.Append(plan.Nodes.ElementsAs(ctx, &nodes, false)...)
+	}
+	selected, err := GetRemediationNodes(reports, nodes)
+	if err != nil {
+		diags.AddError("Error in getting the compliance of the resource group", err.Error())
+		return state, diags
+	}
+
+	compliant := true
+	state.FirmwareRepositoryName = types.StringValue("")
+	summary := make([]models.ResourceGroupRemediationNodeModel, 0, len(selected))
+	for _, report := range selected {
+		components := make([]string, 0)
+		for _, component := range report.ComplianceReportComponents {
+			if !component.Compliant {
+				components = append(components, component.Name)
+			}
+		}
+		componentList, dgs := types.ListValueFrom(ctx, types.StringType, components)
+		diags.Append(dgs...)
+		summary = append(summary, models.ResourceGroupRemediationNodeModel{
+			ServiceTag:             types.StringValue(report.ServiceTag),
+			IPAddress:              types.StringValue(report.IPAddress),
+			HostName:               types.StringValue(report.HostName),
+			Compliant:              types.BoolValue(report.Compliant),
+			NonCompliantComponents: componentList,
+		})
+		compliant = compliant && report.Compliant
+		if report.FirmwareRepositoryName != "" {
+			state.FirmwareRepositoryName = types.StringValue(report.FirmwareRepositoryName)
+		}
+	}
+
+	state.Compliant = types.BoolValue(compliant)
+	summaryList, dgs := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ResourceGroupRemediationNodeAttrTypes}, summary)
+	diags.Append(dgs...)
+	state.ComplianceSummary = summaryList
+	return state, diags
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/dell/goscaleio"
+	"github.com/dell/goscaleio/api"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+)
+
+// DoRestRequest sends a request to the PowerFlex REST API for the operations which are not yet wrapped by goscaleio.
+// It reuses the endpoint, version and token of the authenticated goscaleio client and re-authenticates once on a 401.
+func DoRestRequest(client *goscaleio.Client, method, uri string, body, resp interface{}) error {
+	if client == nil {
+		return fmt.Errorf("PowerFlex client is not initialized")
+	}
+	configConnect := client.GetConfigConnect()
+
+	apiClient, err := api.New(context.Background(), configConnect.Endpoint, api.ClientOptions{
+		Insecure: configConnect.Insecure,
+		UseCerts: true,
+	}, false)
+	if err != nil {
+		return fmt.Errorf("Unable to create PowerFlex REST client: %s", err.Error())
+	}
+	apiClient.SetToken(client.GetToken())
+
+	accept := api.HeaderValContentTypeJSON
+	if configConnect.Version != "" {
+		accept = accept + ";version=" + configConnect.Version
+	}
+	headers := map[string]string{
+		api.HeaderKeyAccept:      accept,
+		api.HeaderKeyContentType: accept,
+	}
+
+	err = apiClient.DoWithHeaders(context.Background(), method, uri, headers, body, resp, configConnect.Version)
+	if err == nil {
+		return nil
+	}
+
+	// the token could have expired, authenticate again and retry once
+	if e, ok := err.(*scaleiotypes.Error); ok && e.HTTPStatusCode == http.StatusUnauthorized {
+		if _, err := client.Authenticate(configConnect); err != nil {
+			return fmt.Errorf("Error Authenticating: %s", err.Error())
+		}
+		apiClient.SetToken(client.GetToken())
+		return apiClient.DoWithHeaders(context.Background(), method, uri, headers, body, resp, configConnect.Version)
+	}
+	return err
+}
@@ -19,6 +19,7 @@ package helper
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"terraform-provider-powerflex/client"
 	"terraform-provider-powerflex/powerflex/models"
@@ -52,6 +53,12 @@ func (l *provisionerLogger) Println(v ...any) {
 // SdcHostResource - helper for SDC host resource
 type SdcHostResource struct {
 	System *goscaleio.System
+	Client *goscaleio.Client
+}
+
+// SdcChapSecretParam defines the payload and response of the SDC CHAP secret actions
+type SdcChapSecretParam struct {
+	SdcPassword string `json:"sdcPassword,omitempty"`
 }
 
 func (r *SdcHostResource) getSSHProvisioner(ctx context.Context, plan models.SdcHostModel) (*client.SSHProvisioner, string, error) {
@@ -164,9 +171,112 @@ func (r *SdcHostResource) ReadSDCHost(ctx context.Context, client *goscaleio.Cli
 		// Just make an empty list if not set by user
 		state.MdmIPs, _ = types.ListValue(types.StringType, []attr.Value{})
 	}
+	if state.EnableAuthentication.IsNull() || state.EnableAuthentication.IsUnknown() {
+		state.EnableAuthentication = types.BoolValue(false)
+	}
+	if state.ChapSecret.IsUnknown() {
+		// The CHAP secret cannot be read back from PowerFlex
+		state.ChapSecret = types.StringNull()
+	}
 	return state, nil
 }
 
+// GenerateSdcChapSecret - generate a new CHAP secret for the SDC on PowerFlex
+func (r *SdcHostResource) GenerateSdcChapSecret(sdcID string) (string, error) {
+	resp := &SdcChapSecretParam{}
+	err := DoRestRequest(r.Client, http.MethodPost, fmt.Sprintf("/api/instances/Sdc::%v/action/generateSdcPassword", sdcID), &SdcChapSecretParam{}, resp)
+	if err != nil {
+		return "", fmt.Errorf("error generating CHAP secret for SDC %s: %w", sdcID, err)
+	}
+	return resp.SdcPassword, nil
+}
+
+// SetSdcChapSecret - set the given CHAP secret for the SDC on PowerFlex
+func (r *SdcHostResource) SetSdcChapSecret(sdcID, secret string) error {
+	err := DoRestRequest(r.Client, http.MethodPost, fmt.Sprintf("/api/instances/Sdc::%v/action/setSdcPassword", sdcID), &SdcChapSecretParam{SdcPassword: secret}, nil)
+	if err != nil {
+		return fmt.Errorf("error setting CHAP secret for SDC %s: %w", sdcID, err)
+	}
+	return nil
+}
+
+// ConfigureSdcAuthentication - set or generate the CHAP secret of the SDC and push it to the host
+// It returns the plan with the CHAP secret which was configured
+func (r *SdcHostResource) ConfigureSdcAuthentication(ctx context.Context, plan models.SdcHostModel, sdcID string) (models.SdcHostModel, diag.Diagnostics) {
+	var respDiagnostics diag.Diagnostics
+	if plan.OS.ValueString() != "linux" {
+		respDiagnostics.AddError(
+			"Error configuring SDC authentication",
+			"SDC authentication can only be configured for linux SDC",
+		)
+		return plan, respDiagnostics
+	}
+
+	secret := plan.ChapSecret.ValueString()
+	if plan.ChapSecret.IsUnknown() || plan.ChapSecret.IsNull() {
+		tflog.Info(ctx, "Generating CHAP secret for SDC "+sdcID)
+		generated, err := r.GenerateSdcChapSecret(sdcID)
+		if err != nil {
+			respDiagnostics.AddError("Error configuring SDC authentication", err.Error())
+			return plan, respDiagnostics
+		}
+		secret = generated
+	} else {
+		tflog.Info(ctx, "Setting CHAP secret for SDC "+sdcID)
+		if err := r.SetSdcChapSecret(sdcID, secret); err != nil {
+			respDiagnostics.AddError("Error configuring SDC authentication", err.Error())
+			return plan, respDiagnostics
+		}
+	}
+	plan.ChapSecret = types.StringValue(secret)
+
+	respDiagnostics.Append(r.UpdateLinuxChapSecret(ctx, plan)...)
+	return plan, respDiagnostics
+}
+
+// UpdateLinuxChapSecret - push the CHAP secret of the SDC to the drv_cfg of the linux host
+func (r *SdcHostResource) UpdateLinuxChapSecret(ctx context.Context, plan models.SdcHostModel) diag.Diagnostics {
+	var respDiagnostics diag.Diagnostics
+	var mdms []string
+	if !plan.MdmIPs.IsUnknown() && len(plan.MdmIPs.Elements()) > 0 {
+		respDiagnostics.Append(plan.MdmIPs.ElementsAs(ctx, &mdms, true)...)
+	} else {
+		clusterIps, diags := r.GetMdmIps(ctx, plan)
+		respDiagnostics.Append(diags...)
+		mdms = []string{strings.Join(clusterIps, ",")}
+	}
+	if respDiagnostics.HasError() {
+		return respDiagnostics
+	}
+
+	// Create the ssh provisioner
+	sshP, _, err := r.getSSHProvisioner(ctx, plan)
+	if err != nil {
+		respDiagnostics.AddError(
+			"Error connecting to host",
+			err.Error(),
+		)
+		return respDiagnostics
+	}
+	defer sshP.Close()
+
+	// The secret is set against the first IP of each MDM cluster known to the SDC
+	for _, mdm := range mdms {
+		mdmIP := strings.Split(mdm, ",")[0]
+		tflog.Info(ctx, "Setting CHAP secret for MDM: "+mdmIP)
+		_, err := sshP.RunWithDir(plan.LinuxDrvCfg.ValueString(), fmt.Sprintf("./drv_cfg --set_mdm_password --ip %s --port 6611 --password %s", mdmIP, client.ShellQuote(plan.ChapSecret.ValueString())))
+		if err != nil {
+			respDiagnostics.AddError(
+				"Error setting CHAP secret for MDM: "+mdmIP,
+				err.Error(),
+			)
+			return respDiagnostics
+		}
+		tflog.Info(ctx, "CHAP secret set")
+	}
+	return respDiagnostics
+}
+
 // SetSDCParams - function to set SDC parameters
 func (r *SdcHostResource) SetSDCParams(ctx context.Context, plan, state models.SdcHostModel) error {
 	// set name
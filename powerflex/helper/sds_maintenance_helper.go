@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SDS maintenance types of the resource
+const (
+	SdsMaintenanceInstant   = "Instant"
+	SdsMaintenanceProtected = "Protected"
+)
+
+// SDS maintenance states reported by PowerFlex
+const (
+	SdsNoMaintenance           = "NoMaintenance"
+	SdsInMaintenance           = "InMaintenance"
+	SdsSetMaintenanceProgress  = "SetMaintenanceInProgress"
+	SdsExitMaintenanceProgress = "ExitMaintenanceInProgress"
+	sdsProtectedMaintenance    = "ProtectedMaintenance"
+)
+
+// SdsMaintenancePollInterval is the interval between two checks of the maintenance state of the SDS
+var SdsMaintenancePollInterval = 10 * time.Second
+
+// IsSdsInMaintenance returns whether the SDS is in or is entering maintenance mode
+func IsSdsInMaintenance(sds scaleiotypes.Sds) bool {
+	return sds.MaintenanceState == SdsInMaintenance || sds.MaintenanceState == SdsSetMaintenanceProgress
+}
+
+// GetSdsMaintenanceType returns the type of the maintenance mode the SDS is in
+func GetSdsMaintenanceType(sds scaleiotypes.Sds) string {
+	if sds.MaintenanceType == sdsProtectedMaintenance {
+		return SdsMaintenanceProtected
+	}
+	return SdsMaintenanceInstant
+}
+
+// GetSdsMaintenanceConflict returns another SDS of the same fault set which is in maintenance mode.
+// SDSs which are not part of a fault set are considered against the other SDSs of the protection domain without fault set.
+func GetSdsMaintenanceConflict(sds scaleiotypes.Sds, allSds []scaleiotypes.Sds) *scaleiotypes.Sds {
+	for _, other := range allSds {
+		if other.ID == sds.ID || other.ProtectionDomainID != sds.ProtectionDomainID || other.FaultSetID != sds.FaultSetID {
+			continue
+		}
+		if IsSdsInMaintenance(other) || other.MaintenanceState == SdsExitMaintenanceProgress {
+			return &other
+		}
+	}
+	return nil
+}
+
+// EnterSdsMaintenanceMode puts the SDS into instant or protected maintenance mode
+func EnterSdsMaintenanceMode(client *goscaleio.Client, id, maintenanceType string) error {
+	action := "enterMaintenanceMode"
+	if maintenanceType == SdsMaintenanceProtected {
+		action = "enterProtectedMaintenanceMode"
+	}
+	return DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/Sds::%v/action/%v", id, action), scaleiotypes.EmptyPayload{}, nil)
+}
+
+// ExitSdsMaintenanceMode takes the SDS out of the maintenance mode it is in
+func ExitSdsMaintenanceMode(client *goscaleio.Client, sds scaleiotypes.Sds) error {
+	action := "exitMaintenanceMode"
+	if sds.MaintenanceType == sdsProtectedMaintenance {
+		action = "exitProtectedMaintenanceMode"
+	}
+	return DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/Sds::%v/action/%v", sds.ID, action), scaleiotypes.EmptyPayload{}, nil)
+}
+
+// WaitForSdsMaintenanceState waits until the SDS reaches the given maintenance state
+func WaitForSdsMaintenanceState(ctx context.Context, system *goscaleio.System, id, state string, timeout time.Duration) (*scaleiotypes.Sds, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		sds, err := system.GetSdsByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if sds.MaintenanceState == state {
+			return &sds, nil
+		}
+		if time.Now().After(deadline) {
+			return &sds, fmt.Errorf("SDS %s is %s after %s, expected %s", id, sds.MaintenanceState, timeout, state)
+		}
+		tflog.Info(ctx, "Waiting for the maintenance state of the SDS", map[string]interface{}{"id": id, "state": sds.MaintenanceState})
+		time.Sleep(SdsMaintenancePollInterval)
+	}
+}
+
+// UpdateSdsMaintenanceState sets the maintenance mode of the SDS in the state
+func UpdateSdsMaintenanceState(plan models.SdsMaintenanceResourceModel, sds *scaleiotypes.Sds) models.SdsMaintenanceResourceModel {
+	state := plan
+	state.ID = types.StringValue(sds.ID)
+	state.SdsID = types.StringValue(sds.ID)
+	state.Enabled = types.BoolValue(IsSdsInMaintenance(*sds))
+	if state.Enabled.ValueBool() {
+		state.MaintenanceType = types.StringValue(GetSdsMaintenanceType(*sds))
+	}
+	state.MaintenanceState = types.StringValue(sds.MaintenanceState)
+	state.FaultSetID = types.StringValue(sds.FaultSetID)
+	state.ProtectionDomainID = types.StringValue(sds.ProtectionDomainID)
+	return state
+}
@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	// SupportAssistConfigurationURI is the PowerFlex Manager URI of the SupportAssist configuration
+	SupportAssistConfigurationURI = "/Api/V1/SupportAssist/Configuration"
+	// SupportAssistTestConnectionURI is the PowerFlex Manager URI to test the SupportAssist connectivity
+	SupportAssistTestConnectionURI = "/Api/V1/SupportAssist/TestConnection"
+	// SupportAssistStatusURI is the PowerFlex Manager URI of the SupportAssist status
+	SupportAssistStatusURI = "/Api/V1/SupportAssist/Status"
+)
+
+// SupportAssistConfiguration defines the SupportAssist configuration of PowerFlex Manager
+type SupportAssistConfiguration struct {
+	ID             string               `json:"id,omitempty"`
+	Enabled        bool                 `json:"enabled"`
+	ConnectionType string               `json:"connectionType"`
+	AccessKey      string               `json:"accessKey,omitempty"`
+	Pin            string               `json:"pin,omitempty"`
+	GatewayHost    string               `json:"gatewayHost,omitempty"`
+	GatewayPort    int                  `json:"gatewayPort,omitempty"`
+	Contact        SupportAssistContact `json:"contact"`
+	Proxy          *SupportAssistProxy  `json:"proxy,omitempty"`
+}
+
+// SupportAssistContact defines the contact details of the SupportAssist configuration
+type SupportAssistContact struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	Phone     string `json:"phone,omitempty"`
+}
+
+// SupportAssistProxy defines the proxy details of the SupportAssist configuration
+type SupportAssistProxy struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// SupportAssistStatus defines the registration and connection status of SupportAssist
+type SupportAssistStatus struct {
+	Registered         bool   `json:"registered"`
+	ConnectionStatus   string `json:"connectionStatus"`
+	LastConnectionTime string `json:"lastConnectionTime"`
+}
+
+// GetSupportAssistConfiguration returns the SupportAssist configuration
+func GetSupportAssistConfiguration(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) (*SupportAssistConfiguration, error) {
+	config := &SupportAssistConfiguration{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, SupportAssistConfigurationURI, nil, config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// SetSupportAssistConfiguration configures and enables SupportAssist
+func SetSupportAssistConfiguration(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, config *SupportAssistConfiguration) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPut, SupportAssistConfigurationURI, config, nil)
+}
+
+// DisableSupportAssist removes the SupportAssist configuration
+func DisableSupportAssist(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodDelete, SupportAssistConfigurationURI, nil, nil)
+}
+
+// TestSupportAssistConnection tests the connectivity of SupportAssist with the Dell backend
+func TestSupportAssistConnection(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPost, SupportAssistTestConnectionURI, map[string]string{}, nil)
+}
+
+// GetSupportAssistStatus returns the registration and connection status of SupportAssist
+func GetSupportAssistStatus(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) (*SupportAssistStatus, error) {
+	status := &SupportAssistStatus{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, SupportAssistStatusURI, nil, status)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// GetSupportAssistContactType returns the attribute types of the contact block
+func GetSupportAssistContactType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"first_name": types.StringType,
+		"last_name":  types.StringType,
+		"email":      types.StringType,
+		"phone":      types.StringType,
+	}
+}
+
+// GetSupportAssistProxyType returns the attribute types of the proxy block
+func GetSupportAssistProxyType() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":     types.StringType,
+		"port":     types.Int64Type,
+		"username": types.StringType,
+		"password": types.StringType,
+	}
+}
+
+// GetSupportAssistPayload builds the SupportAssist configuration from the plan
+func GetSupportAssistPayload(ctx context.Context, plan models.SupportAssistResourceModel) (*SupportAssistConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var contact models.SupportAssistContactModel
+	diags.Append(plan.Contact.As(ctx, &contact, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+
+	config := &SupportAssistConfiguration{
+		Enabled:        true,
+		ConnectionType: plan.ConnectionType.ValueString(),
+		AccessKey:      plan.AccessKey.ValueString(),
+		Pin:            plan.Pin.ValueString(),
+		GatewayHost:    plan.GatewayHost.ValueString(),
+		GatewayPort:    int(plan.GatewayPort.ValueInt64()),
+		Contact: SupportAssistContact{
+			FirstName: contact.FirstName.ValueString(),
+			LastName:  contact.LastName.ValueString(),
+			Email:     contact.Email.ValueString(),
+			Phone:     contact.Phone.ValueString(),
+		},
+	}
+
+	if !plan.Proxy.IsNull() && !plan.Proxy.IsUnknown() {
+		var proxy models.SupportAssistProxyModel
+		diags.Append(plan.Proxy.As(ctx, &proxy, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+		config.Proxy = &SupportAssistProxy{
+			Host:     proxy.Host.ValueString(),
+			Port:     int(proxy.Port.ValueInt64()),
+			Username: proxy.Username.ValueString(),
+			Password: proxy.Password.ValueString(),
+		}
+	}
+	return config, diags
+}
+
+// UpdateSupportAssistState maps the SupportAssist configuration and status to the resource state
+// The access key, PIN and proxy password are not returned by PowerFlex Manager and are kept as is from the plan
+func UpdateSupportAssistState(ctx context.Context, plan models.SupportAssistResourceModel, config *SupportAssistConfiguration, status *SupportAssistStatus) (models.SupportAssistResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	state.ID = types.StringValue("support_assist")
+	if config.ID != "" {
+		state.ID = types.StringValue(config.ID)
+	}
+	state.ConnectionType = types.StringValue(config.ConnectionType)
+	if config.GatewayHost != "" {
+		state.GatewayHost = types.StringValue(config.GatewayHost)
+	}
+	if config.GatewayPort != 0 {
+		state.GatewayPort = types.Int64Value(int64(config.GatewayPort))
+	} else if state.GatewayPort.IsUnknown() {
+		state.GatewayPort = types.Int64Null()
+	}
+
+	var contact models.SupportAssistContactModel
+	if !plan.Contact.IsNull() && !plan.Contact.IsUnknown() {
+		diags.Append(plan.Contact.As(ctx, &contact, basetypes.ObjectAsOptions{})...)
+	}
+	phone := contact.Phone
+	if config.Contact.Phone != "" {
+		phone = types.StringValue(config.Contact.Phone)
+	}
+	contactObj, dgs := types.ObjectValue(GetSupportAssistContactType(), map[string]attr.Value{
+		"first_name": types.StringValue(config.Contact.FirstName),
+		"last_name":  types.StringValue(config.Contact.LastName),
+		"email":      types.StringValue(config.Contact.Email),
+		"phone":      phone,
+	})
+	diags.Append(dgs...)
+	state.Contact = contactObj
+
+	if config.Proxy == nil {
+		state.Proxy = types.ObjectNull(GetSupportAssistProxyType())
+	} else {
+		var proxy models.SupportAssistProxyModel
+		if !plan.Proxy.IsNull() && !plan.Proxy.IsUnknown() {
+			diags.Append(plan.Proxy.As(ctx, &proxy, basetypes.ObjectAsOptions{})...)
+		}
+		username := proxy.Username
+		if config.Proxy.Username != "" {
+			username = types.StringValue(config.Proxy.Username)
+		}
+		proxyObj, dgs := types.ObjectValue(GetSupportAssistProxyType(), map[string]attr.Value{
+			"host":     types.StringValue(config.Proxy.Host),
+			"port":     types.Int64Value(int64(config.Proxy.Port)),
+			"username": username,
+			"password": proxy.Password,
+		})
+		diags.Append(dgs...)
+		state.Proxy = proxyObj
+	}
+
+	state.Registered = types.BoolValue(status.Registered)
+	state.ConnectionStatus = types.StringValue(status.ConnectionStatus)
+	return state, diags
+}
+
+// MapSupportAssistDataSourceState maps the SupportAssist configuration and status to the datasource state
+func MapSupportAssistDataSourceState(config *SupportAssistConfiguration, status *SupportAssistStatus) models.SupportAssistDataSourceModel {
+	state := models.SupportAssistDataSourceModel{
+		ID:                 types.StringValue("support_assist"),
+		Enabled:            types.BoolValue(config.Enabled),
+		Registered:         types.BoolValue(status.Registered),
+		ConnectionType:     types.StringValue(config.ConnectionType),
+		GatewayHost:        types.StringValue(config.GatewayHost),
+		GatewayPort:        types.Int64Value(int64(config.GatewayPort)),
+		ContactEmail:       types.StringValue(config.Contact.Email),
+		ProxyHost:          types.StringValue(""),
+		ConnectionStatus:   types.StringValue(status.ConnectionStatus),
+		LastConnectionTime: types.StringValue(status.LastConnectionTime),
+	}
+	if config.ID != "" {
+		state.ID = types.StringValue(config.ID)
+	}
+	if config.Proxy != nil {
+		state.ProxyHost = types.StringValue(config.Proxy.Host)
+	}
+	return state
+}
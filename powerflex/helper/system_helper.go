@@ -19,6 +19,10 @@ package helper
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"terraform-provider-powerflex/powerflex/models"
 
 	"github.com/dell/goscaleio"
@@ -29,12 +33,219 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// SystemMdmSettings defines the MDM settings of the system which are not part of goscaleio system instance
+type SystemMdmSettings struct {
+	SdcReconnectIntervalInSec    int  `json:"sdcMdmReconnectIntervalInSec"`
+	DefaultAllowMultipleMappings bool `json:"defaultAllowMultipleMappings"`
+	SdcAuthenticationEnabled     bool `json:"sdcAuthenticationEnabled"`
+	// DefaultPerformanceProfile is the performance profile shared by all the SDSs and SDCs of the system, empty if they differ
+	DefaultPerformanceProfile string `json:"-"`
+}
+
+// PerformanceProfileParam defines the payload for setting the performance profile of an SDS or SDC
+type PerformanceProfileParam struct {
+	PerfProfile string `json:"perfProfile"`
+}
+
+// SdcReconnectIntervalParam defines the payload for setting the SDC reconnect interval
+type SdcReconnectIntervalParam struct {
+	SdcReconnectIntervalInSec string `json:"sdcMdmReconnectIntervalInSec"`
+}
+
+// AllowMultipleMappingsParam defines the payload for setting the default allow multiple mappings flag
+type AllowMultipleMappingsParam struct {
+	AllowMultipleMappings string `json:"allowMultipleMappings"`
+}
+
+// GetSystemMdmSettings returns the MDM settings of the system
+func GetSystemMdmSettings(client *goscaleio.Client, systemID string) (*SystemMdmSettings, error) {
+	settings := &SystemMdmSettings{}
+	err := DoRestRequest(client, http.MethodGet, fmt.Sprintf("/api/instances/System::%v", systemID), nil, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var sdsList []scaleiotypes.Sds
+	if err := DoRestRequest(client, http.MethodGet, "/api/types/Sds/instances", nil, &sdsList); err != nil {
+		return nil, err
+	}
+	var sdcList []scaleiotypes.Sdc
+	if err := DoRestRequest(client, http.MethodGet, "/api/types/Sdc/instances", nil, &sdcList); err != nil {
+		return nil, err
+	}
+	profiles := make([]string, 0, len(sdsList)+len(sdcList))
+	for _, sds := range sdsList {
+		profiles = append(profiles, sds.PerformanceProfile)
+	}
+	for _, sdc := range sdcList {
+		profiles = append(profiles, sdc.PerfProfile)
+	}
+	settings.DefaultPerformanceProfile = GetCommonPerformanceProfile(profiles)
+	return settings, nil
+}
+
+// GetCommonPerformanceProfile returns the performance profile shared by all the given profiles, or empty if they differ
+func GetCommonPerformanceProfile(profiles []string) string {
+	common := ""
+	for i, profile := range profiles {
+		if i > 0 && profile != common {
+			return ""
+		}
+		common = profile
+	}
+	return common
+}
+
+// SetSystemDefaultPerformanceProfile sets the performance profile of all the SDSs and SDCs of the system.
+// The performance profile of the MDM cluster is managed by the MDM cluster resource.
+func SetSystemDefaultPerformanceProfile(client *goscaleio.Client, system *goscaleio.System, profile string) error {
+	param := &PerformanceProfileParam{
+		PerfProfile: profile,
+	}
+	sdsList, err := system.GetAllSds()
+	if err != nil {
+		return err
+	}
+	for _, sds := range sdsList {
+		if sds.PerformanceProfile == profile {
+			continue
+		}
+		if err := DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/Sds::%v/action/setSdsPerformanceParameters", sds.ID), param, nil); err != nil {
+			return fmt.Errorf("could not set performance profile of SDS %s: %s", sds.ID, err.Error())
+		}
+	}
+	sdcList, err := system.GetSdc()
+	if err != nil {
+		return err
+	}
+	for _, sdc := range sdcList {
+		if sdc.PerfProfile == profile {
+			continue
+		}
+		if _, err := system.ChangeSdcPerfProfile(sdc.ID, profile); err != nil {
+			return fmt.Errorf("could not set performance profile of SDC %s: %s", sdc.ID, err.Error())
+		}
+	}
+	return nil
+}
+
+// SetSystemCapacityAlertThresholds sets the cluster wide capacity alert thresholds
+func SetSystemCapacityAlertThresholds(client *goscaleio.Client, systemID string, param *scaleiotypes.CapacityAlertThresholdParam) error {
+	return DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/System::%v/action/setCapacityAlertThresholds", systemID), param, nil)
+}
+
+// SetSdcReconnectInterval sets the interval after which a disconnected SDC tries to reconnect to the MDM
+func SetSdcReconnectInterval(client *goscaleio.Client, systemID string, interval int64) error {
+	param := &SdcReconnectIntervalParam{
+		SdcReconnectIntervalInSec: strconv.FormatInt(interval, 10),
+	}
+	return DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/System::%v/action/setSdcMdmReconnectInterval", systemID), param, nil)
+}
+
+// SetDefaultAllowMultipleMappings sets whether a volume can be mapped to multiple SDCs by default
+func SetDefaultAllowMultipleMappings(client *goscaleio.Client, systemID string, allow bool) error {
+	param := &AllowMultipleMappingsParam{
+		AllowMultipleMappings: strings.ToUpper(strconv.FormatBool(allow)),
+	}
+	return DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/System::%v/action/setDefaultAllowMultipleMappings", systemID), param, nil)
+}
+
+// SetSdcAuthentication enables or disables the CHAP authentication of SDCs with the MDM
+func SetSdcAuthentication(client *goscaleio.Client, systemID string, enable bool) error {
+	action := "disableSdcAuthentication"
+	if enable {
+		action = "enableSdcAuthentication"
+	}
+	return DoRestRequest(client, http.MethodPost, fmt.Sprintf("/api/instances/System::%v/action/%v", systemID, action), map[string]string{}, nil)
+}
+
+// UpdateSystemMdmSettings applies the cluster wide defaults which differ between plan and state
+// state is nil during create, in which case every configured setting is applied
+func UpdateSystemMdmSettings(client *goscaleio.Client, system *goscaleio.System, plan, state *models.SystemModel) (diags diag.Diagnostics) {
+	systemID := system.System.ID
+	if state == nil {
+		state = &models.SystemModel{}
+	}
+	changed := func(planValue, stateValue attr.Value) bool {
+		return !planValue.IsNull() && !planValue.IsUnknown() && !planValue.Equal(stateValue)
+	}
+
+	// the threshold which is not configured keeps its current value, which must still be in order
+	if Known(plan.CapacityAlertHighThreshold, plan.CapacityAlertCriticalThreshold) &&
+		plan.CapacityAlertHighThreshold.ValueInt64() >= plan.CapacityAlertCriticalThreshold.ValueInt64() {
+		diags.AddError(
+			"Error in setting capacity alert thresholds",
+			"capacity_alert_high_threshold must be lower than capacity_alert_critical_threshold",
+		)
+		return diags
+	}
+
+	thresholdParam := &scaleiotypes.CapacityAlertThresholdParam{}
+	if changed(plan.CapacityAlertHighThreshold, state.CapacityAlertHighThreshold) {
+		thresholdParam.CapacityAlertHighThresholdPercent = strconv.FormatInt(plan.CapacityAlertHighThreshold.ValueInt64(), 10)
+	}
+	if changed(plan.CapacityAlertCriticalThreshold, state.CapacityAlertCriticalThreshold) {
+		thresholdParam.CapacityAlertCriticalThresholdPercent = strconv.FormatInt(plan.CapacityAlertCriticalThreshold.ValueInt64(), 10)
+	}
+	if thresholdParam.CapacityAlertHighThresholdPercent != "" || thresholdParam.CapacityAlertCriticalThresholdPercent != "" {
+		if err := SetSystemCapacityAlertThresholds(client, systemID, thresholdParam); err != nil {
+			diags.AddError(
+				"Error in setting capacity alert thresholds",
+				"Could not set capacity alert thresholds, unexpected err: "+err.Error(),
+			)
+		}
+	}
+
+	if changed(plan.DefaultPerformanceProfile, state.DefaultPerformanceProfile) {
+		if err := SetSystemDefaultPerformanceProfile(client, system, plan.DefaultPerformanceProfile.ValueString()); err != nil {
+			diags.AddError(
+				"Error in setting default performance profile",
+				"Could not set default performance profile, unexpected err: "+err.Error(),
+			)
+		}
+	}
+
+	if changed(plan.SdcReconnectInterval, state.SdcReconnectInterval) {
+		if err := SetSdcReconnectInterval(client, systemID, plan.SdcReconnectInterval.ValueInt64()); err != nil {
+			diags.AddError(
+				"Error in setting SDC reconnect interval",
+				"Could not set SDC reconnect interval, unexpected err: "+err.Error(),
+			)
+		}
+	}
+
+	if changed(plan.AllowMultipleMappings, state.AllowMultipleMappings) {
+		if err := SetDefaultAllowMultipleMappings(client, systemID, plan.AllowMultipleMappings.ValueBool()); err != nil {
+			diags.AddError(
+				"Error in setting allow multiple mappings",
+				"Could not set allow multiple mappings, unexpected err: "+err.Error(),
+			)
+		}
+	}
+
+	if changed(plan.SdcAuthenticationEnabled, state.SdcAuthenticationEnabled) {
+		if err := SetSdcAuthentication(client, systemID, plan.SdcAuthenticationEnabled.ValueBool()); err != nil {
+			diags.AddError(
+				"Error in setting SDC authentication",
+				"Could not set SDC authentication, unexpected err: "+err.Error(),
+			)
+		}
+	}
+	return diags
+}
+
 // UpdateSystemState updates the state of a system
-func UpdateSystemState(plan *models.SystemModel, system *scaleiotypes.System, r *goscaleio.System) (state *models.SystemModel, diags diag.Diagnostics) {
+func UpdateSystemState(plan *models.SystemModel, system *scaleiotypes.System, settings *SystemMdmSettings, r *goscaleio.System) (state *models.SystemModel, diags diag.Diagnostics) {
 	var tfStateApprovedIPs []attr.Value
 	state = plan
 	state.ID = types.StringValue(system.ID)
 	state.RestrictedMode = types.StringValue(system.RestrictedSdcMode)
+	state.CapacityAlertHighThreshold = types.Int64Value(int64(system.CapacityAlertHighThresholdPercent))
+	state.CapacityAlertCriticalThreshold = types.Int64Value(int64(system.CapacityAlertCriticalThresholdPercent))
+	state.DefaultPerformanceProfile = types.StringValue(settings.DefaultPerformanceProfile)
+	state.SdcReconnectInterval = types.Int64Value(int64(settings.SdcReconnectIntervalInSec))
+	state.AllowMultipleMappings = types.BoolValue(settings.DefaultAllowMultipleMappings)
+	state.SdcAuthenticationEnabled = types.BoolValue(settings.SdcAuthenticationEnabled)
 	sdcGuids := make([]string, 0)
 	diags.Append(plan.SdcGuids.ElementsAs(context.TODO(), &sdcGuids, true)...)
 
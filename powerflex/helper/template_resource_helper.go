@@ -0,0 +1,486 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// TemplateURI is the PowerFlex Manager URI of the templates
+	TemplateURI = "/Api/V1/ServiceTemplate"
+	// TemplateDefaultURI is the PowerFlex Manager URI of the default template, which holds the default definition of each component type
+	TemplateDefaultURI = TemplateURI + "/defaultTemplate"
+	// TemplateComponentNode is the type of the node components of a template
+	TemplateComponentNode = "SERVER"
+	// TemplateComponentCluster is the type of the PowerFlex cluster components of a template
+	TemplateComponentCluster = "SCALEIO"
+	// templateOSImageParameter is the parameter of the node components which holds the OS image
+	templateOSImageParameter = "razor_image"
+)
+
+// templateComponentResources maps the managed component types to the resource holding their parameters
+var templateComponentResources = map[string]string{
+	TemplateComponentNode:    "asm::server",
+	TemplateComponentCluster: "asm::scaleio",
+}
+
+// TemplateComponentAttrTypes defines the attribute types of a template component
+var TemplateComponentAttrTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"name":               types.StringType,
+	"type":               types.StringType,
+	"instances":          types.Int64Type,
+	"os_image":           types.StringType,
+	"parameters":         types.MapType{ElemType: types.StringType},
+	"related_components": types.SetType{ElemType: types.StringType},
+}
+
+// GetTemplateDefaultComponents returns the default definition of the managed component types, with all their resources and parameters
+func GetTemplateDefaultComponents(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client) (map[string]map[string]interface{}, error) {
+	var template map[string]interface{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, TemplateDefaultURI, nil, &template)
+	if err != nil {
+		return nil, err
+	}
+	defaults := make(map[string]map[string]interface{})
+	components, _ := template["components"].([]interface{})
+	for _, item := range components {
+		component, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		componentType, _ := component["type"].(string)
+		if _, managed := templateComponentResources[componentType]; managed && defaults[componentType] == nil {
+			defaults[componentType] = component
+		}
+	}
+	return defaults, nil
+}
+
+// newTemplateComponent returns a new component seeded from the default definition of its type
+func newTemplateComponent(defaults map[string]map[string]interface{}, componentType string) (map[string]interface{}, error) {
+	definition, ok := defaults[componentType]
+	if !ok {
+		return nil, fmt.Errorf("default definition of the components of type %s is not returned by PowerFlex Manager", componentType)
+	}
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+	var component map[string]interface{}
+	err = json.Unmarshal(data, &component)
+	if err != nil {
+		return nil, err
+	}
+	component["id"] = uuid.New().String()
+	delete(component, "relatedComponents")
+	return component, nil
+}
+
+// GetTemplatePayload builds the template from the plan.
+// When an existing template is given, its components are updated in place, so that the parameters which are not managed are preserved.
+// New components are seeded from the default definition of their type before the declared parameters are set.
+func GetTemplatePayload(ctx context.Context, plan models.TemplateResourceModel, existing map[string]interface{}, defaults map[string]map[string]interface{}) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := existing
+	if payload == nil {
+		payload = make(map[string]interface{})
+	}
+
+	payload["templateName"] = plan.Name.ValueString()
+	payload["templateDescription"] = plan.Description.ValueString()
+	if plan.Category.ValueString() != "" {
+		payload["category"] = plan.Category.ValueString()
+	}
+	payload["draft"] = !plan.Published.ValueBool()
+	payload["templateLocked"] = false
+
+	if plan.FirmwareRepositoryID.ValueString() != "" {
+		payload["firmwareRepository"] = map[string]interface{}{"id": plan.FirmwareRepositoryID.ValueString()}
+		payload["manageFirmware"] = true
+		payload["useDefaultCatalog"] = false
+	} else {
+		delete(payload, "firmwareRepository")
+		payload["manageFirmware"] = false
+	}
+
+	var networkIDs []string
+	if !plan.NetworkIDs.IsNull() && !plan.NetworkIDs.IsUnknown() {
+		diags.Append(plan.NetworkIDs.ElementsAs(ctx, &networkIDs, false)...)
+	}
+	networks := make([]interface{}, 0)
+	for _, id := range networkIDs {
+		networks = append(networks, map[string]interface{}{"id": id})
+	}
+	payload["networks"] = networks
+
+	var planComponents []models.TemplateComponentModel
+	diags.Append(plan.Components.ElementsAs(ctx, &planComponents, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// components which are not managed by the resource are preserved
+	components := make([]interface{}, 0)
+	existingComponents := make(map[string]map[string]interface{})
+	existingList, _ := payload["components"].([]interface{})
+	for _, item := range existingList {
+		component, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		componentType, _ := component["type"].(string)
+		if _, managed := templateComponentResources[componentType]; !managed {
+			components = append(components, component)
+			continue
+		}
+		name, _ := component["name"].(string)
+		existingComponents[name] = component
+	}
+
+	componentIDs := make(map[string]string)
+	managedComponents := make([]map[string]interface{}, 0)
+	for _, planComponent := range planComponents {
+		name, componentType := planComponent.Name.ValueString(), planComponent.Type.ValueString()
+		component, ok := existingComponents[name]
+		if !ok {
+			var err error
+			component, err = newTemplateComponent(defaults, componentType)
+			if err != nil {
+				diags.AddError(
+					"Error in template components",
+					fmt.Sprintf("component %s cannot be created: %s", name, err.Error()),
+				)
+				return nil, diags
+			}
+		} else if component["type"] != componentType {
+			diags.AddError(
+				"Error in template components",
+				fmt.Sprintf("type of component %s cannot be changed from %v to %s", name, component["type"], componentType),
+			)
+			return nil, diags
+		}
+		if _, ok := componentIDs[name]; ok {
+			diags.AddError(
+				"Error in template components",
+				fmt.Sprintf("component %s is declared more than once", name),
+			)
+			return nil, diags
+		}
+		componentIDs[name] = component["id"].(string)
+
+		component["name"] = name
+		component["type"] = componentType
+
+		params := make(map[string]string)
+		if !planComponent.Parameters.IsNull() && !planComponent.Parameters.IsUnknown() {
+			diags.Append(planComponent.Parameters.ElementsAs(ctx, &params, false)...)
+		}
+		if componentType == TemplateComponentNode {
+			if !planComponent.Instances.IsNull() && !planComponent.Instances.IsUnknown() {
+				component["instances"] = planComponent.Instances.ValueInt64()
+			}
+			if planComponent.OSImage.ValueString() != "" {
+				params[templateOSImageParameter] = planComponent.OSImage.ValueString()
+			}
+		} else if planComponent.OSImage.ValueString() != "" || (!planComponent.Instances.IsNull() && !planComponent.Instances.IsUnknown()) {
+			diags.AddError(
+				"Error in template components",
+				fmt.Sprintf("instances and os_image can only be set on components of type %s, component %s is of type %s", TemplateComponentNode, name, componentType),
+			)
+			return nil, diags
+		}
+		setTemplateParameters(component, templateComponentResources[componentType], params)
+		managedComponents = append(managedComponents, component)
+	}
+
+	for i, planComponent := range planComponents {
+		var related []string
+		if !planComponent.RelatedComponents.IsNull() && !planComponent.RelatedComponents.IsUnknown() {
+			diags.Append(planComponent.RelatedComponents.ElementsAs(ctx, &related, false)...)
+		}
+		if len(related) == 0 {
+			continue
+		}
+		relatedComponents := make(map[string]interface{})
+		for _, name := range related {
+			id, ok := componentIDs[name]
+			if !ok {
+				diags.AddError(
+					"Error in template components",
+					fmt.Sprintf("related component %s of component %s is not declared", name, planComponent.Name.ValueString()),
+				)
+				return nil, diags
+			}
+			relatedComponents[id] = name
+		}
+		managedComponents[i]["relatedComponents"] = relatedComponents
+	}
+
+	for _, component := range managedComponents {
+		components = append(components, component)
+	}
+	payload["components"] = components
+	return payload, diags
+}
+
+// setTemplateParameters sets the values of the parameters of the given resource of the component
+func setTemplateParameters(component map[string]interface{}, resourceID string, params map[string]string) {
+	resources, _ := component["resources"].([]interface{})
+	var resource map[string]interface{}
+	for _, item := range resources {
+		if r, ok := item.(map[string]interface{}); ok && r["id"] == resourceID {
+			resource = r
+			break
+		}
+	}
+	if resource == nil {
+		resource = map[string]interface{}{"id": resourceID}
+		resources = append(resources, resource)
+	}
+
+	parameters, _ := resource["parameters"].([]interface{})
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		found := false
+		for _, item := range parameters {
+			if parameter, ok := item.(map[string]interface{}); ok && parameter["id"] == key {
+				parameter["value"] = params[key]
+				found = true
+				break
+			}
+		}
+		if !found {
+			parameters = append(parameters, map[string]interface{}{"id": key, "value": params[key]})
+		}
+	}
+	resource["parameters"] = parameters
+	component["resources"] = resources
+}
+
+// CreateTemplate creates the template and returns its ID
+func CreateTemplate(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, payload map[string]interface{}) (string, error) {
+	template := &scaleiotypes.TemplateDetails{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodPost, TemplateURI, payload, template)
+	if err != nil {
+		return "", err
+	}
+	if template.ID == "" {
+		return "", fmt.Errorf("template ID is not returned by PowerFlex Manager")
+	}
+	return template.ID, nil
+}
+
+// GetTemplatePayloadByID returns the template with all its fields, so that it can be updated without losing any of them
+func GetTemplatePayloadByID(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string) (map[string]interface{}, error) {
+	var template map[string]interface{}
+	err := DoGatewayRequest(gatewayClient, client, http.MethodGet, TemplateURI+"/"+id, nil, &template)
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// UpdateTemplate updates the template
+func UpdateTemplate(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string, payload map[string]interface{}) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodPut, TemplateURI+"/"+id, payload, nil)
+}
+
+// DeleteTemplate deletes the template
+func DeleteTemplate(gatewayClient *goscaleio.GatewayClient, client *goscaleio.Client, id string) error {
+	return DoGatewayRequest(gatewayClient, client, http.MethodDelete, TemplateURI+"/"+id, nil, nil)
+}
+
+// getTemplateParameters returns the parameters of the given resource of the component
+func getTemplateParameters(component scaleiotypes.Components, resourceID string) map[string]string {
+	params := make(map[string]string)
+	for _, resource := range component.Resources {
+		if resource.ID != resourceID {
+			continue
+		}
+		for _, parameter := range resource.Parameters {
+			params[parameter.ID] = parameter.Value
+		}
+	}
+	return params
+}
+
+// GetTemplateMissingParameters returns the parameters declared in the plan which are not returned by PowerFlex Manager, as component/parameter
+func GetTemplateMissingParameters(ctx context.Context, plan models.TemplateResourceModel, template *scaleiotypes.TemplateDetails) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var planList []models.TemplateComponentModel
+	if !plan.Components.IsNull() && !plan.Components.IsUnknown() {
+		diags.Append(plan.Components.ElementsAs(ctx, &planList, false)...)
+	}
+
+	missing := make([]string, 0)
+	for _, planComponent := range planList {
+		if planComponent.Parameters.IsNull() || planComponent.Parameters.IsUnknown() {
+			continue
+		}
+		var declaredParams map[string]string
+		diags.Append(planComponent.Parameters.ElementsAs(ctx, &declaredParams, false)...)
+		params := make(map[string]string)
+		for _, component := range template.Components {
+			if component.Name == planComponent.Name.ValueString() {
+				params = getTemplateParameters(component, templateComponentResources[component.Type])
+			}
+		}
+		for key := range declaredParams {
+			if _, ok := params[key]; !ok {
+				missing = append(missing, planComponent.Name.ValueString()+"/"+key)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing, diags
+}
+
+// UpdateTemplateState sets the template in the state.
+// Only the parameters which are declared in the plan are read back, the other parameters are left to PowerFlex Manager.
+// Declared parameters which are not returned by PowerFlex Manager are left out, so that they are planned again.
+func UpdateTemplateState(ctx context.Context, plan models.TemplateResourceModel, template *scaleiotypes.TemplateDetails) (models.TemplateResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	state.ID = types.StringValue(template.ID)
+	state.Name = types.StringValue(template.TemplateName)
+	state.Description = types.StringValue(template.TemplateDescription)
+	if template.TemplateDescription == "" && plan.Description.IsNull() {
+		state.Description = types.StringNull()
+	}
+	state.Category = types.StringValue(template.Category)
+	state.Published = types.BoolValue(!template.Draft)
+	state.NodeCount = types.Int64Value(int64(template.ServerCount))
+
+	if template.ManageFirmware && template.FirmwareRepository.ID != "" {
+		state.FirmwareRepositoryID = types.StringValue(template.FirmwareRepository.ID)
+	} else {
+		state.FirmwareRepositoryID = types.StringNull()
+	}
+
+	networkIDs := make([]string, 0)
+	for _, network := range template.Networks {
+		networkIDs = append(networkIDs, network.ID)
+	}
+	if len(networkIDs) == 0 && plan.NetworkIDs.IsNull() {
+		state.NetworkIDs = types.SetNull(types.StringType)
+	} else {
+		networks, dgs := types.SetValueFrom(ctx, types.StringType, networkIDs)
+		diags.Append(dgs...)
+		state.NetworkIDs = networks
+	}
+
+	planComponents := make(map[string]models.TemplateComponentModel)
+	var planList []models.TemplateComponentModel
+	if !plan.Components.IsNull() && !plan.Components.IsUnknown() {
+		diags.Append(plan.Components.ElementsAs(ctx, &planList, false)...)
+	}
+	for _, component := range planList {
+		planComponents[component.Name.ValueString()] = component
+	}
+
+	// components are listed in the order of the plan, followed by the components which are not declared
+	templateComponents := make(map[string]scaleiotypes.Components)
+	names := make([]string, 0)
+	for _, component := range planList {
+		names = append(names, component.Name.ValueString())
+	}
+	for _, component := range template.Components {
+		if _, managed := templateComponentResources[component.Type]; !managed {
+			continue
+		}
+		templateComponents[component.Name] = component
+		if _, ok := planComponents[component.Name]; !ok {
+			names = append(names, component.Name)
+		}
+	}
+
+	components := make([]models.TemplateComponentModel, 0)
+	for _, name := range names {
+		component, ok := templateComponents[name]
+		if !ok {
+			continue
+		}
+		planComponent, declared := planComponents[name]
+		params := getTemplateParameters(component, templateComponentResources[component.Type])
+
+		model := models.TemplateComponentModel{
+			ID:        types.StringValue(component.ID),
+			Name:      types.StringValue(component.Name),
+			Type:      types.StringValue(component.Type),
+			Instances: types.Int64Null(),
+			OSImage:   types.StringNull(),
+		}
+		if component.Type == TemplateComponentNode {
+			instances := component.Instances
+			if instances < 1 {
+				instances = 1
+			}
+			model.Instances = types.Int64Value(int64(instances))
+			if params[templateOSImageParameter] != "" {
+				model.OSImage = types.StringValue(params[templateOSImageParameter])
+			}
+		}
+
+		model.Parameters = types.MapNull(types.StringType)
+		if declared && !planComponent.Parameters.IsNull() {
+			var declaredParams map[string]string
+			diags.Append(planComponent.Parameters.ElementsAs(ctx, &declaredParams, false)...)
+			values := make(map[string]string)
+			for key := range declaredParams {
+				if value, ok := params[key]; ok {
+					values[key] = value
+				}
+			}
+			paramMap, dgs := types.MapValueFrom(ctx, types.StringType, values)
+			diags.Append(dgs...)
+			model.Parameters = paramMap
+		}
+
+		related := make([]string, 0)
+		for _, relatedName := range component.RelatedComponents {
+			related = append(related, relatedName)
+		}
+		relatedSet, dgs := types.SetValueFrom(ctx, types.StringType, related)
+		diags.Append(dgs...)
+		model.RelatedComponents = relatedSet
+		components = append(components, model)
+	}
+
+	componentList, dgs := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: TemplateComponentAttrTypes}, components)
+	diags.Append(dgs...)
+	state.Components = componentList
+	return state, diags
+}
@@ -38,6 +38,9 @@ type ClusterResourceModel struct {
 	SDRList                            types.Set    `tfsdk:"sdr_list"`
 	SDTList                            types.Set    `tfsdk:"sdt_list"`
 	ProtectionDomains                  types.List   `tfsdk:"protection_domains"`
+	OnInstallationFailure              types.String `tfsdk:"on_installation_failure"`
+	NodeRemovalTimeoutInMinutes        types.Int64  `tfsdk:"node_removal_timeout_in_minutes"`
+	InstallerOperation                 types.Object `tfsdk:"installer_operation"`
 }
 
 // ClusterModel defines the struct for Cluster Details Data
@@ -231,3 +234,11 @@ type StoragePoolCsvRow struct {
 	CompressionMethod                    string
 	ReplicationJournalCapacityPercentage int
 }
+
+// InstallerOperationModel defines the last operation of the gateway installer
+type InstallerOperationModel struct {
+	ID             types.String `tfsdk:"id"`
+	Phase          types.String `tfsdk:"phase"`
+	Status         types.String `tfsdk:"status"`
+	FailedCommands []string     `tfsdk:"failed_commands"`
+}
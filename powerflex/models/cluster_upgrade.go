@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterUpgradeResourceModel defines the schema for the cluster upgrade resource
+type ClusterUpgradeResourceModel struct {
+	ID                                 types.String `tfsdk:"id"`
+	MdmIP                              types.String `tfsdk:"mdm_ip"`
+	MdmPassword                        types.String `tfsdk:"mdm_password"`
+	LiaPassword                        types.String `tfsdk:"lia_password"`
+	AllowNonSecureCommunicationWithMdm types.Bool   `tfsdk:"allow_non_secure_communication_with_mdm"`
+	AllowNonSecureCommunicationWithLia types.Bool   `tfsdk:"allow_non_secure_communication_with_lia"`
+	DisableNonMgmtComponentsAuth       types.Bool   `tfsdk:"disable_non_mgmt_components_auth"`
+	TargetVersion                      types.String `tfsdk:"target_version"`
+	RebuildRebalanceTimeout            types.Int64  `tfsdk:"rebuild_rebalance_timeout_in_minutes"`
+	SystemVersion                      types.String `tfsdk:"system_version"`
+	Steps                              types.List   `tfsdk:"steps"`
+	Components                         types.List   `tfsdk:"components"`
+}
+
+// ClusterUpgradeStepModel defines a step of the cluster upgrade
+type ClusterUpgradeStepModel struct {
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+// ClusterUpgradeComponentModel defines the version of a component of the cluster
+type ClusterUpgradeComponentModel struct {
+	Type       types.String `tfsdk:"type"`
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	IP         types.String `tfsdk:"ip"`
+	FaultSetID types.String `tfsdk:"fault_set_id"`
+	Version    types.String `tfsdk:"version"`
+}
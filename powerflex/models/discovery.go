@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DiscoveryResourceModel defines the schema for the discovery resource
+type DiscoveryResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	IPRanges               types.List   `tfsdk:"ip_ranges"`
+	ResourceType           types.String `tfsdk:"resource_type"`
+	CredentialID           types.String `tfsdk:"credential_id"`
+	Timeout                types.Int64  `tfsdk:"timeout"`
+	RemoveDevicesOnDestroy types.Bool   `tfsdk:"remove_devices_on_destroy"`
+	Status                 types.String `tfsdk:"status"`
+	RefIDs                 types.List   `tfsdk:"ref_ids"`
+	AddedRefIDs            types.List   `tfsdk:"added_ref_ids"`
+	DiscoveredDevices      types.List   `tfsdk:"discovered_devices"`
+}
+
+// DiscoveryIPRangeModel defines an IP range of the discovery
+type DiscoveryIPRangeModel struct {
+	StartIP types.String `tfsdk:"start_ip"`
+	EndIP   types.String `tfsdk:"end_ip"`
+}
+
+// DiscoveredDeviceModel defines a device found by the discovery
+type DiscoveredDeviceModel struct {
+	RefID         types.String `tfsdk:"ref_id"`
+	IPAddress     types.String `tfsdk:"ip_address"`
+	DeviceType    types.String `tfsdk:"device_type"`
+	ServiceTag    types.String `tfsdk:"service_tag"`
+	Model         types.String `tfsdk:"model"`
+	Status        types.String `tfsdk:"status"`
+	StatusMessage types.String `tfsdk:"status_message"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FirmwareRepositoryCompareDataSourceModel defines the schema for the firmware repository compare datasource
+type FirmwareRepositoryCompareDataSourceModel struct {
+	ID                   types.String                 `tfsdk:"id"`
+	TargetRepositoryID   types.String                 `tfsdk:"target_repository_id"`
+	SourceRepositoryID   types.String                 `tfsdk:"source_repository_id"`
+	ResourceGroupID      types.String                 `tfsdk:"resource_group_id"`
+	IncludeUnchanged     types.Bool                   `tfsdk:"include_unchanged"`
+	TargetRepositoryName types.String                 `tfsdk:"target_repository_name"`
+	SourceName           types.String                 `tfsdk:"source_name"`
+	UpgradeCount         types.Int64                  `tfsdk:"upgrade_count"`
+	DowngradeCount       types.Int64                  `tfsdk:"downgrade_count"`
+	MissingCount         types.Int64                  `tfsdk:"missing_count"`
+	ComponentDiffs       []FirmwareComponentDiffModel `tfsdk:"component_diffs"`
+}
+
+// FirmwareComponentDiffModel defines the difference of the version of a component between the source and the target repository
+type FirmwareComponentDiffModel struct {
+	Name            types.String `tfsdk:"name"`
+	ComponentType   types.String `tfsdk:"component_type"`
+	OperatingSystem types.String `tfsdk:"operating_system"`
+	Node            types.String `tfsdk:"node"`
+	SourceVersion   types.String `tfsdk:"source_version"`
+	TargetVersion   types.String `tfsdk:"target_version"`
+	Change          types.String `tfsdk:"change"`
+}
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LicenseResourceModel defines the model for license resource
+type LicenseResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	LicenseFilePath           types.String `tfsdk:"license_file_path"`
+	LicenseFileHash           types.String `tfsdk:"license_file_hash"`
+	InstallationID            types.String `tfsdk:"installation_id"`
+	Swid                      types.String `tfsdk:"swid"`
+	MaxCapacityInGb           types.String `tfsdk:"max_capacity_in_gb"`
+	CapacityTimeLeftInDays    types.String `tfsdk:"capacity_time_left_in_days"`
+	DaysInstalled             types.Int64  `tfsdk:"days_installed"`
+	EnterpriseFeaturesEnabled types.Bool   `tfsdk:"enterprise_features_enabled"`
+	IsInitialLicense          types.Bool   `tfsdk:"is_initial_license"`
+}
+
+// LicenseDataSourceModel defines the model for license datasource
+type LicenseDataSourceModel struct {
+	ID                        types.String  `tfsdk:"id"`
+	InstallationID            types.String  `tfsdk:"installation_id"`
+	Swid                      types.String  `tfsdk:"swid"`
+	MaxCapacityInGb           types.String  `tfsdk:"max_capacity_in_gb"`
+	UsedCapacityInGb          types.Float64 `tfsdk:"used_capacity_in_gb"`
+	CapacityTimeLeftInDays    types.String  `tfsdk:"capacity_time_left_in_days"`
+	DaysInstalled             types.Int64   `tfsdk:"days_installed"`
+	EnterpriseFeaturesEnabled types.Bool    `tfsdk:"enterprise_features_enabled"`
+	IsInitialLicense          types.Bool    `tfsdk:"is_initial_license"`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkResourceModel defines the schema for the network resource
+type NetworkResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Type         types.String `tfsdk:"type"`
+	VlanID       types.Int64  `tfsdk:"vlan_id"`
+	Static       types.Bool   `tfsdk:"static"`
+	Subnet       types.String `tfsdk:"subnet"`
+	Gateway      types.String `tfsdk:"gateway"`
+	PrimaryDNS   types.String `tfsdk:"primary_dns"`
+	SecondaryDNS types.String `tfsdk:"secondary_dns"`
+	DNSSuffix    types.String `tfsdk:"dns_suffix"`
+	IPRanges     types.List   `tfsdk:"ip_ranges"`
+	StaticRoutes types.List   `tfsdk:"static_routes"`
+}
+
+// NetworkIPRangeModel defines an IP range of the network
+type NetworkIPRangeModel struct {
+	ID      types.String `tfsdk:"id"`
+	StartIP types.String `tfsdk:"start_ip"`
+	EndIP   types.String `tfsdk:"end_ip"`
+	Role    types.String `tfsdk:"role"`
+}
+
+// NetworkStaticRouteModel defines a static route of the network
+type NetworkStaticRouteModel struct {
+	SourceNetworkID      types.String `tfsdk:"source_network_id"`
+	DestinationNetworkID types.String `tfsdk:"destination_network_id"`
+	Gateway              types.String `tfsdk:"gateway"`
+	SubnetMask           types.String `tfsdk:"subnet_mask"`
+	DestinationIPAddress types.String `tfsdk:"destination_ip_address"`
+}
+
+// NetworkDataSourceModel defines the schema for the network data source
+type NetworkDataSourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	NetworkFilter  *NetworkFilter `tfsdk:"filter"`
+	NetworkDetails []NetworkModel `tfsdk:"network_details"`
+}
+
+// NetworkFilter defines the filter of the network data source
+type NetworkFilter struct {
+	ID     []types.String `tfsdk:"id"`
+	Name   []types.String `tfsdk:"name"`
+	Type   []types.String `tfsdk:"type"`
+	VlanID []types.Int64  `tfsdk:"vlan_id"`
+}
+
+// NetworkModel defines a network of the network data source
+type NetworkModel struct {
+	ID           types.String              `tfsdk:"id"`
+	Name         types.String              `tfsdk:"name"`
+	Description  types.String              `tfsdk:"description"`
+	Type         types.String              `tfsdk:"type"`
+	VlanID       types.Int64               `tfsdk:"vlan_id"`
+	Static       types.Bool                `tfsdk:"static"`
+	Subnet       types.String              `tfsdk:"subnet"`
+	Gateway      types.String              `tfsdk:"gateway"`
+	PrimaryDNS   types.String              `tfsdk:"primary_dns"`
+	SecondaryDNS types.String              `tfsdk:"secondary_dns"`
+	DNSSuffix    types.String              `tfsdk:"dns_suffix"`
+	IPRanges     []NetworkIPRangeModel     `tfsdk:"ip_ranges"`
+	StaticRoutes []NetworkStaticRouteModel `tfsdk:"static_routes"`
+}
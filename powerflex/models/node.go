@@ -86,6 +86,7 @@ type NodeModel struct {
 	MemoryInGB          types.Int64     `tfsdk:"memory_in_gb"`
 	ComplianceCheckDate types.String    `tfsdk:"compliance_check_date"`
 	DiscoveredDate      types.String    `tfsdk:"discovered_date"`
+	LastRefreshDate     types.String    `tfsdk:"last_refresh_date"`
 	DeviceGroupList     DeviceGroupList `tfsdk:"device_group_list"`
 	CredID              types.String    `tfsdk:"cred_id"`
 	Compliance          types.String    `tfsdk:"compliance"`
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NodeInventoryRefreshResourceModel defines the schema for the node inventory refresh resource
+type NodeInventoryRefreshResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Nodes       types.Set    `tfsdk:"nodes"`
+	MaxAge      types.Int64  `tfsdk:"max_age"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+	NodeDetails types.List   `tfsdk:"node_details"`
+}
+
+// NodeInventoryModel defines the inventory and health of a node after the refresh
+type NodeInventoryModel struct {
+	RefID           types.String `tfsdk:"ref_id"`
+	ServiceTag      types.String `tfsdk:"service_tag"`
+	IPAddress       types.String `tfsdk:"ip_address"`
+	State           types.String `tfsdk:"state"`
+	Health          types.String `tfsdk:"health"`
+	HealthMessage   types.String `tfsdk:"health_message"`
+	LastRefreshDate types.String `tfsdk:"last_refresh_date"`
+}
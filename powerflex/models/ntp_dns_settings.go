@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NtpDNSSettingsResourceModel defines the schema for the NTP DNS settings resource
+type NtpDNSSettingsResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	NtpServers     types.List   `tfsdk:"ntp_servers"`
+	DNSServers     types.List   `tfsdk:"dns_servers"`
+	Timezone       types.String `tfsdk:"timezone"`
+	ClusterNodes   types.Object `tfsdk:"cluster_nodes"`
+	OutOfSyncNodes types.List   `tfsdk:"out_of_sync_nodes"`
+}
+
+// NtpDNSClusterNodesModel defines the cluster nodes of the NTP DNS settings resource
+type NtpDNSClusterNodesModel struct {
+	MdmIP       types.String `tfsdk:"mdm_ip"`
+	MdmPassword types.String `tfsdk:"mdm_password"`
+	LiaPassword types.String `tfsdk:"lia_password"`
+	NodeIPs     types.List   `tfsdk:"node_ips"`
+}
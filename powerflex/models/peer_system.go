@@ -60,20 +60,23 @@ type PeerMdmFilter struct {
 
 // PeerMdmResourceModel model for Peer Mdm
 type PeerMdmResourceModel struct {
-	ID                        types.String   `tfsdk:"id"`
-	Name                      types.String   `tfsdk:"name"`
-	Port                      types.Int64    `tfsdk:"port"`
-	PeerSystemID              types.String   `tfsdk:"peer_system_id"`
-	SystemID                  types.String   `tfsdk:"system_id"`
-	SoftwareVersionInfo       types.String   `tfsdk:"software_version_info"`
-	MembershipState           types.String   `tfsdk:"membership_state"`
-	PerfProfile               types.String   `tfsdk:"perf_profile"`
-	NetworkType               types.String   `tfsdk:"network_type"`
-	CouplingRC                types.String   `tfsdk:"coupling_rc"`
-	DestinationPrimaryMdmInfo types.Object   `tfsdk:"destination_primary_mdm_information"`
-	SourcePrimaryMdmInfo      types.Object   `tfsdk:"source_primary_mdm_information"`
-	AddCertificate            types.Bool     `tfsdk:"add_certificate"`
-	IPList                    []types.String `tfsdk:"ip_list"`
+	ID                         types.String   `tfsdk:"id"`
+	Name                       types.String   `tfsdk:"name"`
+	Port                       types.Int64    `tfsdk:"port"`
+	PeerSystemID               types.String   `tfsdk:"peer_system_id"`
+	SystemID                   types.String   `tfsdk:"system_id"`
+	SoftwareVersionInfo        types.String   `tfsdk:"software_version_info"`
+	MembershipState            types.String   `tfsdk:"membership_state"`
+	PerfProfile                types.String   `tfsdk:"perf_profile"`
+	NetworkType                types.String   `tfsdk:"network_type"`
+	CouplingRC                 types.String   `tfsdk:"coupling_rc"`
+	DestinationPrimaryMdmInfo  types.Object   `tfsdk:"destination_primary_mdm_information"`
+	SourcePrimaryMdmInfo       types.Object   `tfsdk:"source_primary_mdm_information"`
+	AddCertificate             types.Bool     `tfsdk:"add_certificate"`
+	CertificateExchangeMethod  types.String   `tfsdk:"certificate_exchange_method"`
+	DestinationRootCertificate types.String   `tfsdk:"destination_root_certificate"`
+	ManagementInsecure         types.Bool     `tfsdk:"management_insecure"`
+	IPList                     []types.String `tfsdk:"ip_list"`
 }
 
 // PrimaryMdmInfo model for Primary a Mdm
@@ -38,6 +38,43 @@ type ReplicationPairResourceModel struct {
 	SourceVolumeID                     types.String `tfsdk:"source_volume_id"`
 	DestinationVolumeID                types.String `tfsdk:"destination_volume_id"`
 	PauseCopy                          types.Bool   `tfsdk:"pause_initial_copy"`
+	DestinationVolume                  types.Object `tfsdk:"destination_volume"`
+}
+
+// ReplicationPairDestinationVolumeModel defines the model for provisioning the destination volume on the peer system
+type ReplicationPairDestinationVolumeModel struct {
+	Endpoint             types.String `tfsdk:"endpoint"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	Insecure             types.Bool   `tfsdk:"insecure"`
+	ProtectionDomainName types.String `tfsdk:"protection_domain_name"`
+	StoragePoolName      types.String `tfsdk:"storage_pool_name"`
+	NamePattern          types.String `tfsdk:"name_pattern"`
+	VolumeType           types.String `tfsdk:"volume_type"`
+	DeleteOnDestroy      types.Bool   `tfsdk:"delete_on_destroy"`
+}
+
+// ReplicationPairsResourceModel defines the model for managing the replication pairs of a set of source volumes
+type ReplicationPairsResourceModel struct {
+	ID                            types.String `tfsdk:"id"`
+	ReplicationConsistencyGroupID types.String `tfsdk:"replication_consistency_group_id"`
+	SourceVolumeIDs               types.Set    `tfsdk:"source_volume_ids"`
+	SourceVolumeNameRegex         types.String `tfsdk:"source_volume_name_regex"`
+	PairNamePattern               types.String `tfsdk:"pair_name_pattern"`
+	PausedSourceVolumeIDs         types.Set    `tfsdk:"paused_source_volume_ids"`
+	DestinationVolume             types.Object `tfsdk:"destination_volume"`
+	Pairs                         types.List   `tfsdk:"pairs"`
+}
+
+// ReplicationPairsPairModel defines the model for a replication pair managed by the replication pairs resource
+type ReplicationPairsPairModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	SourceVolumeID        types.String `tfsdk:"source_volume_id"`
+	DestinationVolumeID   types.String `tfsdk:"destination_volume_id"`
+	DestinationVolumeName types.String `tfsdk:"destination_volume_name"`
+	InitialCopyState      types.String `tfsdk:"initial_copy_state"`
+	Paused                types.Bool   `tfsdk:"paused"`
 }
 
 // ReplicationPairModel model for the replication pair
@@ -158,6 +195,46 @@ type ReplicationConsistencyGroupFilter struct {
 
 // ReplicationConsistencyGroupAction defines the model for ReplicationConsistencyGroupAction
 type ReplicationConsistencyGroupAction struct {
-	ID     types.String `tfsdk:"id"`
-	Action types.String `tfsdk:"action"`
+	ID                          types.String `tfsdk:"id"`
+	Action                      types.String `tfsdk:"action"`
+	WaitForCompletion           types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout                     types.Int64  `tfsdk:"timeout"`
+	FailoverType                types.String `tfsdk:"failover_type"`
+	FailoverState               types.String `tfsdk:"failover_state"`
+	DisasterRecoveryState       types.String `tfsdk:"disaster_recovery_state"`
+	RemoteDisasterRecoveryState types.String `tfsdk:"remote_disaster_recovery_state"`
+	CurrConsistMode             types.String `tfsdk:"curr_consist_mode"`
+	AbstractState               types.String `tfsdk:"abstract_state"`
+	SnapshotGroupID             types.String `tfsdk:"snapshot_group_id"`
+	SnapshotIDs                 types.Map    `tfsdk:"snapshot_ids"`
+	TargetSystem                types.Object `tfsdk:"target_system"`
+}
+
+// PeerSystemConnectionModel defines the connection details of the peer system
+type PeerSystemConnectionModel struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Insecure types.Bool   `tfsdk:"insecure"`
+}
+
+// ReplicationConsistencyGroupSnapshotDataSourceModel defines the model for the snapshots of a replication consistency group
+type ReplicationConsistencyGroupSnapshotDataSourceModel struct {
+	ID                            types.String                               `tfsdk:"id"`
+	ReplicationConsistencyGroupID types.String                               `tfsdk:"replication_consistency_group_id"`
+	SnapshotGroupID               types.String                               `tfsdk:"snapshot_group_id"`
+	Snapshots                     []ReplicationConsistencyGroupSnapshotModel `tfsdk:"snapshots"`
+}
+
+// ReplicationConsistencyGroupSnapshotModel defines a snapshot of a volume of a replication consistency group
+type ReplicationConsistencyGroupSnapshotModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	SnapshotGroupID   types.String `tfsdk:"snapshot_group_id"`
+	VolumeID          types.String `tfsdk:"volume_id"`
+	VolumeName        types.String `tfsdk:"volume_name"`
+	SourceVolumeID    types.String `tfsdk:"source_volume_id"`
+	SizeInKb          types.Int64  `tfsdk:"size_in_kb"`
+	CreationTime      types.Int64  `tfsdk:"creation_time"`
+	ReplicationPairID types.String `tfsdk:"replication_pair_id"`
 }
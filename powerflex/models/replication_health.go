@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ReplicationHealthDataSourceModel is the tfsdk model of ReplicationHealth data source schema
+type ReplicationHealthDataSourceModel struct {
+	ID                                types.String                       `tfsdk:"id"`
+	Compliant                         types.Bool                         `tfsdk:"compliant"`
+	ReplicationHealthDetails          []ReplicationHealthModel           `tfsdk:"replication_health_details"`
+	ReplicationConsistencyGroupFilter *ReplicationConsistencyGroupFilter `tfsdk:"filter"`
+}
+
+// ReplicationHealthModel defines the health and RPO compliance of a replication consistency group
+type ReplicationHealthModel struct {
+	ID                      types.String                 `tfsdk:"id"`
+	Name                    types.String                 `tfsdk:"name"`
+	RpoInSeconds            types.Int64                  `tfsdk:"rpo_in_seconds"`
+	LagInSeconds            types.Int64                  `tfsdk:"lag_in_seconds"`
+	AbstractState           types.String                 `tfsdk:"abstract_state"`
+	Error                   types.Int64                  `tfsdk:"error"`
+	InactiveReason          types.Int64                  `tfsdk:"inactive_reason"`
+	InitialCopyComplete     types.Bool                   `tfsdk:"initial_copy_complete"`
+	ProtectionDomainID      types.String                 `tfsdk:"protection_domain_id"`
+	JournalCapacityMaxRatio types.Int64                  `tfsdk:"journal_capacity_max_ratio"`
+	JournalCapacityInKb     types.Int64                  `tfsdk:"journal_capacity_in_kb"`
+	JournalUsedInKb         types.Int64                  `tfsdk:"journal_used_in_kb"`
+	JournalUsagePercent     types.Float64                `tfsdk:"journal_usage_percent"`
+	JournalUsedRatio        types.Float64                `tfsdk:"journal_used_ratio"`
+	Compliant               types.Bool                   `tfsdk:"compliant"`
+	NonCompliantReasons     []types.String               `tfsdk:"non_compliant_reasons"`
+	ReplicationPairs        []ReplicationHealthPairModel `tfsdk:"replication_pairs"`
+}
+
+// ReplicationHealthPairModel defines the initial copy progress of a replication pair
+type ReplicationHealthPairModel struct {
+	ID                  types.String  `tfsdk:"id"`
+	Name                types.String  `tfsdk:"name"`
+	InitialCopyState    types.String  `tfsdk:"initial_copy_state"`
+	InitialCopyProgress types.Float64 `tfsdk:"initial_copy_progress"`
+}
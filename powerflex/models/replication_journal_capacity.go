@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ReplicationJournalCapacityDataSourceModel is the tfsdk model of ReplicationJournalCapacity data source schema
+type ReplicationJournalCapacityDataSourceModel struct {
+	ID                          types.String                          `tfsdk:"id"`
+	ProtectionDomainID          types.String                          `tfsdk:"protection_domain_id"`
+	ProtectionDomainName        types.String                          `tfsdk:"protection_domain_name"`
+	WriteBandwidthInMBPerSecond types.Float64                         `tfsdk:"write_bandwidth_in_mb_per_second"`
+	RpoInSeconds                types.Int64                           `tfsdk:"rpo_in_seconds"`
+	OutageToleranceInMinutes    types.Int64                           `tfsdk:"outage_tolerance_in_minutes"`
+	RequiredJournalCapacityInKb types.Int64                           `tfsdk:"required_journal_capacity_in_kb"`
+	Sufficient                  types.Bool                            `tfsdk:"sufficient"`
+	StoragePools                []ReplicationJournalCapacityPoolModel `tfsdk:"storage_pools"`
+}
+
+// ReplicationJournalCapacityPoolModel defines the configured and recommended journal capacity of a storage pool
+type ReplicationJournalCapacityPoolModel struct {
+	ID                             types.String `tfsdk:"id"`
+	Name                           types.String `tfsdk:"name"`
+	CapacityInKb                   types.Int64  `tfsdk:"capacity_in_kb"`
+	ConfiguredPercentage           types.Int64  `tfsdk:"configured_percentage"`
+	ConfiguredJournalCapacityInKb  types.Int64  `tfsdk:"configured_journal_capacity_in_kb"`
+	RecommendedPercentage          types.Int64  `tfsdk:"recommended_percentage"`
+	RecommendedJournalCapacityInKb types.Int64  `tfsdk:"recommended_journal_capacity_in_kb"`
+	Sufficient                     types.Bool   `tfsdk:"sufficient"`
+}
@@ -36,6 +36,36 @@ type ResourceGroupResourceModel struct {
 	DeploymentTimeout     types.Int64  `tfsdk:"deployment_timeout"`
 	ServersInInventory    types.String `tfsdk:"servers_in_inventory"`
 	ServersManagedState   types.String `tfsdk:"servers_managed_state"`
+	RemoveNodes           types.Set    `tfsdk:"remove_nodes"`
+	ReplaceNodes          types.List   `tfsdk:"replace_nodes"`
+	DeployedNodes         types.List   `tfsdk:"deployed_nodes"`
+	Overrides             types.List   `tfsdk:"overrides"`
+	ResetIdrac            types.Bool   `tfsdk:"reset_idrac"`
+	DeleteVMs             types.Bool   `tfsdk:"delete_vms"`
+	RetryOnFailure        types.Int64  `tfsdk:"retry_on_failure"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
+}
+
+// ResourceGroupOverrideModel is the tfsdk model of a template parameter override of Resource Group
+type ResourceGroupOverrideModel struct {
+	Component types.String `tfsdk:"component"`
+	Parameter types.String `tfsdk:"parameter"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// ResourceGroupNodeReplacementModel is the tfsdk model of a node replacement of Resource Group
+type ResourceGroupNodeReplacementModel struct {
+	Node  types.String `tfsdk:"node"`
+	RefID types.String `tfsdk:"ref_id"`
+}
+
+// ResourceGroupDeployedNodeModel is the tfsdk model of a node deployed by Resource Group
+type ResourceGroupDeployedNodeModel struct {
+	RefID       types.String `tfsdk:"ref_id"`
+	ServiceTag  types.String `tfsdk:"service_tag"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	ComponentID types.String `tfsdk:"component_id"`
+	Status      types.String `tfsdk:"status"`
 }
 
 // ResourceGroupFilter is the filter of Resource Group
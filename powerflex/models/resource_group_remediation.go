@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceGroupRemediationResourceModel defines the schema for the resource group remediation resource
+type ResourceGroupRemediationResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	ResourceGroupID        types.String `tfsdk:"resource_group_id"`
+	Nodes                  types.Set    `tfsdk:"nodes"`
+	Rolling                types.Bool   `tfsdk:"rolling"`
+	ExitMaintenanceMode    types.Bool   `tfsdk:"exit_maintenance_mode"`
+	Timeout                types.Int64  `tfsdk:"timeout"`
+	Triggers               types.Map    `tfsdk:"triggers"`
+	FirmwareRepositoryName types.String `tfsdk:"firmware_repository_name"`
+	Compliant              types.Bool   `tfsdk:"compliant"`
+	ComplianceSummary      types.List   `tfsdk:"compliance_summary"`
+}
+
+// ResourceGroupRemediationNodeModel defines the compliance of a node of the resource group after the remediation
+type ResourceGroupRemediationNodeModel struct {
+	ServiceTag             types.String `tfsdk:"service_tag"`
+	IPAddress              types.String `tfsdk:"ip_address"`
+	HostName               types.String `tfsdk:"host_name"`
+	Compliant              types.Bool   `tfsdk:"compliant"`
+	NonCompliantComponents types.List   `tfsdk:"non_compliant_components"`
+}
@@ -32,6 +32,10 @@ type SdcHostModel struct {
 	MdmIPs             types.List   `tfsdk:"clusters_mdm_ips"`
 	UseRemotePath      types.Bool   `tfsdk:"use_remote_path"`
 
+	// optional, SDC authentication
+	EnableAuthentication types.Bool   `tfsdk:"enable_authentication"`
+	ChapSecret           types.String `tfsdk:"chap_secret"`
+
 	// optional, os specific
 	Esxi types.Object `tfsdk:"esxi"`
 
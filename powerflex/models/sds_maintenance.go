@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SdsMaintenanceResourceModel defines the schema for the SDS maintenance resource
+type SdsMaintenanceResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	SdsID              types.String `tfsdk:"sds_id"`
+	MaintenanceType    types.String `tfsdk:"maintenance_type"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	MaintenanceState   types.String `tfsdk:"maintenance_state"`
+	FaultSetID         types.String `tfsdk:"fault_set_id"`
+	ProtectionDomainID types.String `tfsdk:"protection_domain_id"`
+}
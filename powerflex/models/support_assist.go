@@ -0,0 +1,67 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SupportAssistResourceModel defines the schema for the SupportAssist resource
+type SupportAssistResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ConnectionType   types.String `tfsdk:"connection_type"`
+	AccessKey        types.String `tfsdk:"access_key"`
+	Pin              types.String `tfsdk:"pin"`
+	GatewayHost      types.String `tfsdk:"gateway_host"`
+	GatewayPort      types.Int64  `tfsdk:"gateway_port"`
+	Contact          types.Object `tfsdk:"contact"`
+	Proxy            types.Object `tfsdk:"proxy"`
+	TestConnection   types.Bool   `tfsdk:"test_connection"`
+	Registered       types.Bool   `tfsdk:"registered"`
+	ConnectionStatus types.String `tfsdk:"connection_status"`
+}
+
+// SupportAssistContactModel defines the contact details of the SupportAssist resource
+type SupportAssistContactModel struct {
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Email     types.String `tfsdk:"email"`
+	Phone     types.String `tfsdk:"phone"`
+}
+
+// SupportAssistProxyModel defines the proxy details of the SupportAssist resource
+type SupportAssistProxyModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+// SupportAssistDataSourceModel defines the schema for the SupportAssist datasource
+type SupportAssistDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Registered         types.Bool   `tfsdk:"registered"`
+	ConnectionType     types.String `tfsdk:"connection_type"`
+	GatewayHost        types.String `tfsdk:"gateway_host"`
+	GatewayPort        types.Int64  `tfsdk:"gateway_port"`
+	ContactEmail       types.String `tfsdk:"contact_email"`
+	ProxyHost          types.String `tfsdk:"proxy_host"`
+	ConnectionStatus   types.String `tfsdk:"connection_status"`
+	LastConnectionTime types.String `tfsdk:"last_connection_time"`
+}
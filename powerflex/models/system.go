@@ -29,6 +29,13 @@ type SystemModel struct {
 	SdcApprovedIPs types.List   `tfsdk:"sdc_approved_ips"`
 	SdcIDs         types.List   `tfsdk:"sdc_ids"`
 	SdcNames       types.List   `tfsdk:"sdc_names"`
+
+	CapacityAlertHighThreshold     types.Int64  `tfsdk:"capacity_alert_high_threshold"`
+	CapacityAlertCriticalThreshold types.Int64  `tfsdk:"capacity_alert_critical_threshold"`
+	DefaultPerformanceProfile      types.String `tfsdk:"default_performance_profile"`
+	SdcReconnectInterval           types.Int64  `tfsdk:"sdc_reconnect_interval"`
+	AllowMultipleMappings          types.Bool   `tfsdk:"allow_multiple_mappings"`
+	SdcAuthenticationEnabled       types.Bool   `tfsdk:"sdc_authentication_enabled"`
 }
 
 // SdcApprovedIPsModel maps the struct to SdcApprovedIPs schema
@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TemplateResourceModel defines the schema for the template resource
+type TemplateResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Category             types.String `tfsdk:"category"`
+	FirmwareRepositoryID types.String `tfsdk:"firmware_repository_id"`
+	NetworkIDs           types.Set    `tfsdk:"network_ids"`
+	Published            types.Bool   `tfsdk:"published"`
+	Components           types.List   `tfsdk:"components"`
+	NodeCount            types.Int64  `tfsdk:"node_count"`
+}
+
+// TemplateComponentModel defines a component of the template
+type TemplateComponentModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	Instances         types.Int64  `tfsdk:"instances"`
+	OSImage           types.String `tfsdk:"os_image"`
+	Parameters        types.Map    `tfsdk:"parameters"`
+	RelatedComponents types.Set    `tfsdk:"related_components"`
+}
@@ -19,9 +19,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"terraform-provider-powerflex/powerflex/helper"
 	"terraform-provider-powerflex/powerflex/models"
+	"time"
 
 	"github.com/dell/goscaleio"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -35,6 +37,7 @@ var (
 	_ resource.Resource                = &clusterResource{}
 	_ resource.ResourceWithConfigure   = &clusterResource{}
 	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
 )
 
 // NewClusterResource is a helper function to simplify the provider implementation.
@@ -353,6 +356,7 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 	resp.Diagnostics.Append(diags...)
 
 	if len(clusterInstallationDetailsDataModel) > 0 {
+		// a failed installer operation is reported as a warning and persisted in the state, so that the next apply resumes or rolls it back
 		data, dgs := r.ClusterDeploymentOperations(ctx, plan, clusterInstallationDetailsDataModel, storagePoolDetailsDataModel)
 		resp.Diagnostics.Append(dgs...)
 		if resp.Diagnostics.HasError() {
@@ -382,6 +386,21 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	operation, diags := helper.GetInstallerOperationFromValue(state.InstallerOperation)
+	resp.Diagnostics.Append(diags...)
+	pending := helper.IsInstallerOperationPending(operation)
+	if pending {
+		resp.Diagnostics.AddWarning(
+			"Cluster installation is not completed",
+			"Installer operation "+operation.ID+" stopped in phase "+operation.Phase+" with status "+operation.Status+
+				", it will be handled according to on_installation_failure on the next apply.",
+		)
+		// the cluster of a failed installation is not installed, there is nothing to refresh
+		if state.MDMList.IsNull() {
+			return
+		}
+	}
+
 	cookieError := helper.RenewInstallationCookie(r.gatewayClient)
 	if cookieError != nil {
 		resp.Diagnostics.AddWarning(
@@ -390,14 +409,16 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 	}
 
-	// to make gateway available for installation
-	queueOperationError := helper.ResetInstallerQueue(r.gatewayClient)
-	if queueOperationError != nil {
-		resp.Diagnostics.AddError(
-			"Error Clearing Queue",
-			"unexpected error: "+queueOperationError.Error(),
-		)
-		return
+	// to make gateway available for installation, unless the installer is left as is so that the failed operation can be resumed or rolled back
+	if !pending {
+		queueOperationError := helper.ResetInstallerQueue(r.gatewayClient)
+		if queueOperationError != nil {
+			resp.Diagnostics.AddError(
+				"Error Clearing Queue",
+				"unexpected error: "+queueOperationError.Error(),
+			)
+			return
+		}
 	}
 
 	//For handling the import case
@@ -417,6 +438,10 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 			state.AllowNonSecureCommunicationWithLia = types.BoolValue(true)
 
+			state.OnInstallationFailure = types.StringValue(helper.InstallationFailureResume)
+
+			state.NodeRemovalTimeoutInMinutes = types.Int64Value(360)
+
 			data, diags := helper.UpdateClusterState(state, r.gatewayClient, mdmIP)
 			if resp.Diagnostics.HasError() {
 				return
@@ -466,11 +491,208 @@ func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ModifyPlan forces an update of the cluster while the last installer operation is not completed, so that the update resumes or rolls it back
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation, diags := helper.GetInstallerOperationFromValue(state.InstallerOperation)
+	resp.Diagnostics.Append(diags...)
+	if !helper.IsInstallerOperationPending(operation) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Cluster installation is not completed",
+		"Installer operation "+operation.ID+" stopped in phase "+operation.Phase+", this apply will "+plan.OnInstallationFailure.ValueString()+" it.",
+	)
+
+	var configID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &configID)...)
+	if configID.IsNull() {
+		plan.ID = types.StringUnknown()
+	}
+	plan.InstallerOperation = types.ObjectUnknown(helper.InstallerOperationAttrTypes)
+	plan.MDMList = types.SetUnknown(plan.MDMList.ElementType(ctx))
+	plan.SDSList = types.SetUnknown(plan.SDSList.ElementType(ctx))
+	plan.SDCList = types.SetUnknown(plan.SDCList.ElementType(ctx))
+	plan.SDRList = types.SetUnknown(plan.SDRList.ElementType(ctx))
+	plan.SDTList = types.SetUnknown(plan.SDTList.ElementType(ctx))
+	plan.ProtectionDomains = types.ListUnknown(plan.ProtectionDomains.ElementType(ctx))
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
+// A failed installer operation recorded in the state is resumed or rolled back according to on_installation_failure.
+// Only the addition and removal of SDS and SDC nodes in the cluster list is supported.
 func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "[POWERFLEX] Update")
+
+	var plan, state models.ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if changed := helper.GetClusterUnsupportedChanges(plan, state); len(changed) > 0 {
+		resp.Diagnostics.AddError(
+			"[Update] Unsupported update of the cluster",
+			"Only the cluster list can be updated, the following attributes cannot be changed: "+strings.Join(changed, ", "),
+		)
+		return
+	}
+
+	cookieError := helper.RenewInstallationCookie(r.gatewayClient)
+	if cookieError != nil {
+		resp.Diagnostics.AddWarning(
+			"Error for renewing installation cookie.",
+			"unexpected error: "+cookieError.Error(),
+		)
+	}
+
+	planNodes := []models.ClusterModel{}
+	resp.Diagnostics.Append(plan.Cluster.ElementsAs(ctx, &planNodes, true)...)
+
+	storagePoolDetailsDataModel := []models.StoragePoolDataModel{}
+	resp.Diagnostics.Append(plan.StoragePools.ElementsAs(ctx, &storagePoolDetailsDataModel, true)...)
+
+	lastOperation, dgs := helper.GetInstallerOperationFromValue(state.InstallerOperation)
+	resp.Diagnostics.Append(dgs...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	pending := helper.IsInstallerOperationPending(lastOperation)
+
+	// the cluster of a failed installation is not installed yet
+	if pending && state.MDMList.IsNull() {
+		data, dgs := r.retryInstallation(ctx, plan, state, lastOperation, planNodes, storagePoolDetailsDataModel)
+		resp.Diagnostics.Append(dgs...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+		return
+	}
+	if plan.InstallerOperation.IsUnknown() {
+		plan.InstallerOperation = state.InstallerOperation
+	}
+
+	mdmList := []models.MDMModel{}
+	resp.Diagnostics.Append(state.MDMList.ElementsAs(ctx, &mdmList, true)...)
+
+	mdmIP, err := helper.GetMDMIPFromMDMList(mdmList)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in Fecthing Primary MDM IP",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	stateNodes := []models.ClusterModel{}
+	if !state.Cluster.IsNull() && !state.Cluster.IsUnknown() {
+		resp.Diagnostics.Append(state.Cluster.ElementsAs(ctx, &stateNodes, true)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an imported cluster has no cluster list in the state, the configured list is adopted as it is
+	if len(stateNodes) == 0 {
+		resp.Diagnostics.AddWarning(
+			"Cluster list adopted without changes",
+			"The state does not contain the cluster list, the configured cluster list is stored without adding or removing any node.",
+		)
+		data, dgs := helper.UpdateClusterState(plan, r.gatewayClient, mdmIP)
+		resp.Diagnostics.Append(dgs...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+		return
+	}
+
+	added, removed, err := helper.GetClusterNodeChanges(stateNodes, planNodes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"[Update] Invalid update of the cluster list",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// the nodes of a failed expansion are not configured anymore, the operation is abandoned
+	if pending && len(added) == 0 {
+		tflog.Info(ctx, "Abandoning gateway installer operation", map[string]interface{}{"operation": lastOperation.ID, "phase": lastOperation.Phase})
+		queueOperationError := helper.ResetInstallerQueue(r.gatewayClient)
+		if queueOperationError != nil {
+			resp.Diagnostics.AddError(
+				"Error Clearing Queue",
+				"unexpected error: "+queueOperationError.Error(),
+			)
+			return
+		}
+		plan.InstallerOperation = types.ObjectNull(helper.InstallerOperationAttrTypes)
+	}
+
+	if len(added) > 0 {
+		tflog.Info(ctx, "Adding nodes to the cluster", map[string]interface{}{"count": len(added)})
+		operation, expansionError := r.expandCluster(ctx, plan, state, planNodes, storagePoolDetailsDataModel)
+		if operation != nil {
+			operationValue, dgs := helper.GetInstallerOperationValue(operation)
+			resp.Diagnostics.Append(dgs...)
+			plan.InstallerOperation = operationValue
+			state.InstallerOperation = operationValue
+		}
+		if expansionError != nil {
+			resp.Diagnostics.AddError(
+				"Error in Expansion Process",
+				"unexpected error: "+expansionError.Error(),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			return
+		}
+	}
 
-	resp.Diagnostics.AddError("[Update] Update operation is not available.", "Update operation is not available.")
+	if len(removed) > 0 {
+		tflog.Info(ctx, "Removing nodes from the cluster", map[string]interface{}{"count": len(removed)})
+		removalTimeout := time.Duration(plan.NodeRemovalTimeoutInMinutes.ValueInt64()) * time.Minute
+		removalError := helper.RemoveClusterNodes(ctx, r.gatewayClient, r.client, state, mdmIP, removed, removalTimeout)
+		if removalError != nil {
+			resp.Diagnostics.AddError(
+				"Error in Node Removal Process",
+				"unexpected error: "+removalError.Error(),
+			)
+			// keep the nodes which could not be confirmed as removed in the state, so that the removal is retried
+			clusterList, dgs := types.ListValueFrom(ctx, plan.Cluster.ElementType(ctx), append(planNodes, removed...))
+			resp.Diagnostics.Append(dgs...)
+			if dgs.HasError() {
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+				return
+			}
+			plan.Cluster = clusterList
+		}
+	}
+
+	data, dgs := helper.UpdateClusterState(plan, r.gatewayClient, mdmIP)
+	resp.Diagnostics.Append(dgs...)
+	if dgs.HasError() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	tflog.Info(ctx, "Cluster Details updated to state file successfully")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -483,6 +705,22 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	operation, diags := helper.GetInstallerOperationFromValue(state.InstallerOperation)
+	resp.Diagnostics.Append(diags...)
+	// the cluster of a failed installation is always rolled back, it is kept in the state if it cannot be
+	if helper.IsInstallerOperationPending(operation) && state.MDMList.IsNull() {
+		rollbackError := r.rollbackInstallation(ctx, state, operation)
+		if rollbackError != nil {
+			resp.Diagnostics.AddError(
+				"Error in Rollback Process",
+				"The installer operation "+operation.ID+" could not be rolled back, the partially installed cluster must be uninstalled before it is removed from the state: "+rollbackError.Error(),
+			)
+			return
+		}
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	mdmList := []models.MDMModel{}
 	diags = state.MDMList.ElementsAs(ctx, &mdmList, true)
 	resp.Diagnostics.Append(diags...)
@@ -575,17 +813,113 @@ func (r *clusterResource) ClusterDeploymentOperations(ctx context.Context, plan
 
 	tflog.Info(ctx, "Gateway Installer CSV Parsed successfully")
 
-	installationError := helper.ClusterInstallationOperations(ctx, plan, r.gatewayClient, parsecsvRespose)
+	operation, installationError := helper.ClusterInstallationOperations(ctx, plan, r.gatewayClient, parsecsvRespose)
+
+	return r.completeInstallation(ctx, plan, mdmIP, operation, installationError)
+}
 
+// completeInstallation returns the state of the cluster after the installer operation.
+// When the operation failed in the installer, it is reported as a warning and the state contains the failed operation, with its last phase and failed commands.
+func (r *clusterResource) completeInstallation(ctx context.Context, plan models.ClusterResourceModel, mdmIP string, operation *helper.InstallerOperation, installationError error) (data models.ClusterResourceModel, dia diag.Diagnostics) {
 	if installationError != nil {
-		dia.AddError(
-			"Error in Installation Process",
-			"unexpected error: "+installationError.Error(),
+		detail := "unexpected error: " + installationError.Error()
+		if operation == nil || operation.ID == "" {
+			dia.AddError("Error in Installation Process", detail)
+			return
+		}
+		data, dia = helper.ClusterFailedInstallationState(ctx, plan, operation)
+		dia.AddWarning(
+			"Cluster installation is not completed",
+			detail+"\nThe installer operation is left in phase "+operation.Phase+", the next apply will "+plan.OnInstallationFailure.ValueString()+" it.",
 		)
 		return
 	}
 
+	operationValue, dia := helper.GetInstallerOperationValue(operation)
+	if dia.HasError() {
+		return
+	}
+
 	data, dia = helper.UpdateClusterState(plan, r.gatewayClient, mdmIP)
+	data.InstallerOperation = operationValue
 
 	return data, dia
 }
+
+// expandCluster installs the nodes added to the cluster list, resuming the failed expansion recorded in the state if it is still in the installer queue
+func (r *clusterResource) expandCluster(ctx context.Context, plan, state models.ClusterResourceModel, planNodes []models.ClusterModel, storagePools []models.StoragePoolDataModel) (*helper.InstallerOperation, error) {
+	lastOperation, _ := helper.GetInstallerOperationFromValue(state.InstallerOperation)
+	if plan.OnInstallationFailure.ValueString() == helper.InstallationFailureResume {
+		operation, err := helper.GetRecordedInstallerOperation(r.gatewayClient, lastOperation, planNodes)
+		if err != nil {
+			return nil, err
+		}
+		if operation != nil {
+			return helper.ResumeClusterInstallation(ctx, r.gatewayClient, operation)
+		}
+	}
+	return helper.ClusterExpansionOperations(ctx, plan, r.gatewayClient, planNodes, storagePools)
+}
+
+// retryInstallation resumes or rolls back the failed installation recorded in the state according to on_installation_failure, and installs the cluster.
+// When resuming, the installer queue is reset before installing if it does not hold the recorded operation anymore.
+func (r *clusterResource) retryInstallation(ctx context.Context, plan, state models.ClusterResourceModel, lastOperation *helper.InstallerOperation, planNodes []models.ClusterModel, storagePools []models.StoragePoolDataModel) (data models.ClusterResourceModel, dia diag.Diagnostics) {
+	if plan.OnInstallationFailure.ValueString() == helper.InstallationFailureRollback {
+		rollbackError := r.rollbackInstallation(ctx, state, lastOperation)
+		if rollbackError != nil {
+			dia.AddError(
+				"Error in Rollback Process",
+				"unexpected error: "+rollbackError.Error(),
+			)
+			return
+		}
+		return r.ClusterDeploymentOperations(ctx, plan, planNodes, storagePools)
+	}
+
+	operation, err := helper.GetRecordedInstallerOperation(r.gatewayClient, lastOperation, planNodes)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to check the installer queue for a failed installation: "+err.Error())
+	}
+	if operation == nil {
+		return r.ClusterDeploymentOperations(ctx, plan, planNodes, storagePools)
+	}
+
+	mdmIP, err := helper.GetMDMIPFromClusterDetails(planNodes)
+	if err != nil {
+		dia.AddError(
+			"Error in Fecthing Primary MDM IP Before Installation",
+			"unexpected error: "+err.Error(),
+		)
+		return
+	}
+	operation, err = helper.ResumeClusterInstallation(ctx, r.gatewayClient, operation)
+	return r.completeInstallation(ctx, plan, mdmIP, operation, err)
+}
+
+// rollbackInstallation aborts the failed installer operation and uninstalls the components it installed on the nodes of the cluster list of the state
+func (r *clusterResource) rollbackInstallation(ctx context.Context, state models.ClusterResourceModel, operation *helper.InstallerOperation) error {
+	tflog.Info(ctx, "Rolling back gateway installer operation", map[string]interface{}{"operation": operation.ID, "phase": operation.Phase})
+	queueOperationError := helper.ResetInstallerQueue(r.gatewayClient)
+	if queueOperationError != nil {
+		return fmt.Errorf("Error while clearing the installer queue is %s", queueOperationError.Error())
+	}
+
+	// nothing is installed on the nodes before the install phase
+	if !helper.IsInstallerOperationInstalling(operation) {
+		return nil
+	}
+
+	clusterNodes := []models.ClusterModel{}
+	if diags := state.Cluster.ElementsAs(ctx, &clusterNodes, true); diags.HasError() {
+		return fmt.Errorf("Error while reading the cluster list of the state")
+	}
+	mdmIP, err := helper.GetMDMIPFromClusterDetails(clusterNodes)
+	if err != nil {
+		return err
+	}
+	clusterDetails, err := helper.GetClusterDetails(state, r.gatewayClient, mdmIP, true)
+	if err != nil {
+		return fmt.Errorf("Error while getting the partially installed cluster is %s", err.Error())
+	}
+	return helper.ClusterUninstallationOperations(ctx, state, r.gatewayClient, clusterDetails)
+}
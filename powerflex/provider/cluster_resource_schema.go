@@ -20,18 +20,23 @@ package provider
 import (
 	"terraform-provider-powerflex/powerflex/helper"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ClusterReourceSchema defines the schema for cluster resource
 var ClusterReourceSchema schema.Schema = schema.Schema{
-	Description:         "This terraform resource is used to deploy the PowerFlex Cluster. We can Create and Delete the PowerFlex Cluster using this resource. We can also Import an existing Cluster of the PowerFlex.",
-	MarkdownDescription: "This terraform resource is used to deploy the PowerFlex Cluster. We can Create and Delete the PowerFlex Cluster using this resource. We can also Import an existing Cluster of the PowerFlex.",
+	Description:         "This terraform resource is used to deploy the PowerFlex Cluster. We can Create and Delete the PowerFlex Cluster using this resource. SDS and SDC nodes can be added to or removed from the `cluster` list of a deployed Cluster. We can also Import an existing Cluster of the PowerFlex.",
+	MarkdownDescription: "This terraform resource is used to deploy the PowerFlex Cluster. We can Create and Delete the PowerFlex Cluster using this resource. SDS and SDC nodes can be added to or removed from the `cluster` list of a deployed Cluster. We can also Import an existing Cluster of the PowerFlex.",
 	Attributes:          ClusterResourceModelSchema(),
 }
 
@@ -137,6 +142,65 @@ func ClusterResourceModelSchema() map[string]schema.Attribute {
 			Computed:            true,
 			NestedObject:        schema.NestedAttributeObject{Attributes: ClusterSDTModelSchema()},
 		},
+
+		"on_installation_failure": schema.StringAttribute{
+			MarkdownDescription: "Behavior of the next apply when the gateway installer fails. The failed operation is kept in `installer_operation` and the next apply updates the cluster to handle it. With `resume`, the installer is left in the failed phase and the next apply retries it and runs the remaining phases." +
+				" With `rollback`, the next apply aborts the installer and uninstalls the cluster before installing it again. Destroying the cluster always rolls the failed installation back." +
+				" Only the failed operation recorded in `installer_operation` is resumed, when it is still in the installer queue and all its nodes are configured in the cluster list, otherwise the installer queue is reset before installing. Default value is `resume`.",
+			Description: "Behavior of the next apply when the gateway installer fails. The failed operation is kept in installer_operation and the next apply updates the cluster to handle it. With resume, the installer is left in the failed phase and the next apply retries it and runs the remaining phases." +
+				" With rollback, the next apply aborts the installer and uninstalls the cluster before installing it again. Destroying the cluster always rolls the failed installation back." +
+				" Only the failed operation recorded in installer_operation is resumed, when it is still in the installer queue and all its nodes are configured in the cluster list, otherwise the installer queue is reset before installing. Default value is resume.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(helper.InstallationFailureResume),
+			Validators: []validator.String{stringvalidator.OneOf(
+				helper.InstallationFailureResume,
+				helper.InstallationFailureRollback,
+			)},
+		},
+
+		"node_removal_timeout_in_minutes": schema.Int64Attribute{
+			MarkdownDescription: "Maximum time to wait for the gateway installer to remove the nodes removed from the cluster list, including the migration of the data of their SDSs to the other SDSs. Default value is `360`.",
+			Description:         "Maximum time to wait for the gateway installer to remove the nodes removed from the cluster list, including the migration of the data of their SDSs to the other SDSs. Default value is 360.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(360),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+
+		"installer_operation": schema.SingleNestedAttribute{
+			MarkdownDescription: "Last operation of the gateway installer.",
+			Description:         "Last operation of the gateway installer.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Object{
+				objectplanmodifier.UseStateForUnknown(),
+			},
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "ID of the installer operation, derived from the start time of its first command.",
+					Description:         "ID of the installer operation, derived from the start time of its first command.",
+					Computed:            true,
+				},
+				"phase": schema.StringAttribute{
+					MarkdownDescription: "Last phase of the installer operation.",
+					Description:         "Last phase of the installer operation.",
+					Computed:            true,
+				},
+				"status": schema.StringAttribute{
+					MarkdownDescription: "Status of the installer operation, `Completed`, `Failed` or `TimedOut`.",
+					Description:         "Status of the installer operation, Completed, Failed or TimedOut.",
+					Computed:            true,
+				},
+				"failed_commands": schema.ListAttribute{
+					MarkdownDescription: "Commands of the last phase which failed or timed out.",
+					Description:         "Commands of the last phase which failed or timed out.",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+			},
+		},
 	}
 }
 
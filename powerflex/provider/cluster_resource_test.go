@@ -20,12 +20,17 @@ package provider
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"regexp"
 	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
 	"testing"
+	"time"
 
 	. "github.com/bytedance/mockey"
 	goscaleio_types "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -76,6 +81,108 @@ func validateSDCLength(state *terraform.State) error {
 	return nil
 }
 
+// TestClusterNodeChanges tests the detection of the nodes added to and removed from the cluster list
+func TestClusterNodeChanges(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	node := func(ip, role, sds string) models.ClusterModel {
+		return models.ClusterModel{
+			IP:        types.StringValue(ip),
+			IsMdmOrTb: types.StringValue(role),
+			IsSds:     types.StringValue(sds),
+			IsSdc:     types.StringValue("Yes"),
+		}
+	}
+	stateNodes := []models.ClusterModel{
+		node("10.0.0.1", "Primary", "Yes"),
+		node("10.0.0.2", "Secondary", "Yes"),
+		node("10.0.0.3", "TB", "Yes"),
+		node("10.0.0.4", "", "Yes"),
+	}
+
+	// add one node and remove one node
+	planNodes := append([]models.ClusterModel{}, stateNodes[:3]...)
+	planNodes = append(planNodes, node("10.0.0.5", "", "Yes"))
+	added, removed, err := helper.GetClusterNodeChanges(stateNodes, planNodes)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(added) != 1 || added[0].IP.ValueString() != "10.0.0.5" {
+		t.Fatalf("expected node 10.0.0.5 to be added, got %v", added)
+	}
+	if len(removed) != 1 || removed[0].IP.ValueString() != "10.0.0.4" {
+		t.Fatalf("expected node 10.0.0.4 to be removed, got %v", removed)
+	}
+
+	// removing an MDM node is not supported
+	if _, _, err := helper.GetClusterNodeChanges(stateNodes, stateNodes[1:]); err == nil {
+		t.Fatal("expected error when removing the primary MDM")
+	}
+
+	// modifying an existing node is not supported
+	modified := append([]models.ClusterModel{}, stateNodes...)
+	modified[3] = node("10.0.0.4", "", "No")
+	if _, _, err := helper.GetClusterNodeChanges(stateNodes, modified); err == nil {
+		t.Fatal("expected error when modifying an existing node")
+	}
+}
+
+// TestClusterNodeRemovalTopology tests the topology given to the gateway installer to remove nodes
+func TestClusterNodeRemovalTopology(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	topology := `{"mdmIPs":["10.0.0.1"],"masterMdm":{"node":{"nodeIPs":["10.0.0.1"]}},"tbSet":[{"node":{"nodeIPs":["10.0.0.3"]}}],` +
+		`"sdsList":[{"node":{"nodeIPs":["10.0.0.1"]}},{"node":{"nodeIPs":["10.0.0.4"]}}],` +
+		`"sdcList":[{"node":{"nodeIPs":["10.0.0.4"]}},{"node":{"nodeIPs":["10.0.0.5"]}}],"protectionDomains":[{"name":"pd1"}]}`
+	removed := []models.ClusterModel{{
+		IP:    types.StringValue("10.0.0.4"),
+		IsSds: types.StringValue("Yes"),
+		IsSdc: types.StringValue("No"),
+	}}
+
+	// only the SDS of the removed node is part of the removal
+	removal, err := helper.GetClusterNodeRemovalTopology(topology, removed)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(removal["sdsList"].([]interface{})) != 1 || len(removal["sdcList"].([]interface{})) != 0 {
+		t.Fatalf("expected only the SDS of node 10.0.0.4 to be removed, got %v", removal)
+	}
+	if _, ok := removal["masterMdm"]; ok {
+		t.Fatal("expected the MDMs not to be part of the removal")
+	}
+	if _, ok := removal["tbSet"]; ok || len(removal["protectionDomains"].([]interface{})) != 0 {
+		t.Fatalf("expected the other components not to be part of the removal, got %v", removal)
+	}
+
+	// nodes which are no longer part of the cluster are already removed
+	removed[0].IP = types.StringValue("10.0.0.6")
+	if removal, err := helper.GetClusterNodeRemovalTopology(topology, removed); err != nil || removal != nil {
+		t.Fatalf("expected no removal, got %v, %v", removal, err)
+	}
+}
+
+// TestClusterUnsupportedChanges tests the detection of the changes other than the cluster list
+func TestClusterUnsupportedChanges(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	state := models.ClusterResourceModel{
+		MdmPassword:                        types.StringValue("Password"),
+		AllowNonSecureCommunicationWithLia: types.BoolValue(false),
+		StoragePools:                       types.ListNull(types.StringType),
+	}
+	plan := state
+	plan.AllowNonSecureCommunicationWithLia = types.BoolValue(true)
+	plan.StoragePools = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("pool1")})
+	changed := helper.GetClusterUnsupportedChanges(plan, state)
+	if len(changed) != 1 || changed[0] != "allow_non_secure_communication_with_lia" {
+		t.Fatalf("expected only allow_non_secure_communication_with_lia to be changed, got %v", changed)
+	}
+}
+
 func TestAccResourceClusterValidation(t *testing.T) {
 	var FunctionMockerClusterCreate *Mocker
 	var FunctionMockerClusterMdmIp *Mocker
@@ -93,7 +200,7 @@ func TestAccResourceClusterValidation(t *testing.T) {
 						Message: "",
 						Data:    "",
 					}, nil).Build()
-					FunctionMockerClusterCreate = Mock(helper.ClusterInstallationOperations).Return(nil).Build()
+					FunctionMockerClusterCreate = Mock(helper.ClusterInstallationOperations).Return(nil, nil).Build()
 					FunctionMockerClusterMdmIp = Mock(helper.GetMDMIPFromClusterDetails).Return("tfacc_cluster_ip_1", nil).Build()
 				},
 				Config: ProviderConfigForTesting + ClusterConfigValidator,
@@ -137,7 +244,7 @@ func TestAccResourceClusterValidation(t *testing.T) {
 						Message: "",
 						Data:    "",
 					}, nil).Build()
-					FunctionMockerClusterCreate = Mock(helper.ClusterInstallationOperations).Return(fmt.Errorf("Mock Error")).Build()
+					FunctionMockerClusterCreate = Mock(helper.ClusterInstallationOperations).Return(nil, fmt.Errorf("Mock Error")).Build()
 					FunctionMockerClusterMdmIp = Mock(helper.GetMDMIPFromClusterDetails).Return("tfacc_cluster_ip_1", nil).Build()
 				},
 				Config: ProviderConfigForTesting + ClusterConfigValidator,
@@ -162,7 +269,7 @@ func TestAccResourceClusterValidation(t *testing.T) {
 						Message: "",
 						Data:    "",
 					}, nil).Build()
-					FunctionMockerClusterCreate = Mock(helper.ClusterInstallationOperations).Return(nil).Build()
+					FunctionMockerClusterCreate = Mock(helper.ClusterInstallationOperations).Return(nil, nil).Build()
 					FunctionMockerClusterMdmIp = Mock(helper.GetMDMIPFromClusterDetails).Return(nil, fmt.Errorf("Mock Error")).Build()
 				},
 				Config: ProviderConfigForTesting + ClusterConfigValidator,
@@ -232,6 +339,139 @@ func TestAccResourceClusterValidation(t *testing.T) {
 	})
 }
 
+// TestAccResourceClusterInstallerOperationUT tests that a failed installer operation is kept in the state, resumed by the next apply and rolled back on destroy
+func TestAccResourceClusterInstallerOperationUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var resumableMocker, resumeMocker, queueMocker, mdmIPMocker, detailsMocker, installMocker, uninstallMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// installer fails in the install phase, the phase and the failed commands are reported
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					operation := &helper.InstallerOperation{
+						ID:             "installer-1760000000",
+						Phase:          "install",
+						Status:         helper.InstallerOperationFailed,
+						FailedCommands: []string{"10.10.10.2: installSds failed package not found"},
+					}
+					FunctionMocker = Mock(helper.ParseClusterCSVOperation).Return(&goscaleio_types.GatewayResponse{}, nil).Build()
+					resumableMocker = Mock(helper.GetRecordedInstallerOperation).Return(nil, nil).Build()
+					queueMocker = Mock(helper.ResetInstallerQueue).Return(nil).Build()
+					mdmIPMocker = Mock(helper.GetMDMIPFromClusterDetails).Return("tfacc_cluster_ip_1", nil).Build()
+					detailsMocker = Mock(helper.GetClusterDetails).Return(nil, fmt.Errorf("Mock Error")).Build()
+					installMocker = Mock(helper.ClusterInstallationOperations).Return(operation, &helper.InstallerOperationError{Operation: operation, Message: "Mock Error"}).Build()
+				},
+				Config: ProviderConfigForTesting + ClusterConfigValidator,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_cluster.test", "id", "installer-1760000000"),
+					resource.TestCheckResourceAttr("powerflex_cluster.test", "installer_operation.phase", "install"),
+					resource.TestCheckResourceAttr("powerflex_cluster.test", "installer_operation.status", helper.InstallerOperationFailed),
+					resource.TestCheckResourceAttr("powerflex_cluster.test", "installer_operation.failed_commands.0", "10.10.10.2: installSds failed package not found"),
+				),
+				// the failed operation kept in the state forces an update which resumes it
+				ExpectNonEmptyPlan: true,
+			},
+			// the update resumes the failed operation, which fails again in the configure phase
+			{
+				PreConfig: func() {
+					operation := &helper.InstallerOperation{
+						ID:             "installer-1760000000",
+						Phase:          "configure",
+						Status:         helper.InstallerOperationTimedOut,
+						FailedCommands: []string{"10.10.10.1: configureMdm timed out"},
+					}
+					resumableMocker.UnPatch()
+					resumableMocker = Mock(helper.GetRecordedInstallerOperation).Return(&helper.InstallerOperation{ID: "installer-1760000000", Phase: "install", Status: helper.InstallerOperationFailed}, nil).Build()
+					resumeMocker = Mock(helper.ResumeClusterInstallation).Return(operation, &helper.InstallerOperationError{Operation: operation, Message: "Mock Error"}).Build()
+				},
+				Config: ProviderConfigForTesting + ClusterConfigValidator,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_cluster.test", "installer_operation.phase", "configure"),
+					resource.TestCheckResourceAttr("powerflex_cluster.test", "installer_operation.status", helper.InstallerOperationTimedOut),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// the destroy is refused while the partially installed cluster cannot be uninstalled
+			{
+				Config:      ProviderConfigForTesting + ClusterConfigValidator,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`.*Error in Rollback Process*`),
+			},
+			// the destroy rolls back the failed operation
+			{
+				PreConfig: func() {
+					detailsMocker.UnPatch()
+					detailsMocker = Mock(helper.GetClusterDetails).Return(&goscaleio_types.GatewayResponse{}, nil).Build()
+					uninstallMocker = Mock(helper.ClusterUninstallationOperations).Return(nil).Build()
+				},
+				Config:  ProviderConfigForTesting + ClusterConfigValidator,
+				Destroy: true,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			for _, mocker := range []*Mocker{resumableMocker, resumeMocker, queueMocker, mdmIPMocker, detailsMocker, installMocker, uninstallMocker} {
+				if mocker != nil {
+					mocker.UnPatch()
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// TestInstallerQueueProgress tests the phase, the failed commands and the operation ID read from the installer queue
+func TestInstallerQueueProgress(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	start := time.Unix(1760000000, 0)
+	commands := []goscaleio_types.MDMQueueCommandDetails{
+		{CommandName: "query", AllowedPhase: "query", CommandState: "completed", TargetEntityIdentifier: "10.10.10.1", NodeIPs: []string{"10.10.10.1"}, StartTime: start},
+		{CommandName: "installMdm", AllowedPhase: "install", CommandState: "completed", TargetEntityIdentifier: "10.10.10.1", NodeIPs: []string{"10.10.10.1"}, StartTime: start.Add(time.Minute)},
+		{CommandName: "installSds", AllowedPhase: "install", CommandState: "failed", TargetEntityIdentifier: "10.10.10.2", NodeIPs: []string{"10.10.10.2"}, Message: "package not found", StartTime: start.Add(time.Minute)},
+	}
+	if phase := helper.GetInstallerCurrentPhase(commands); phase != "install" {
+		t.Fatalf("expected phase install, got %s", phase)
+	}
+	if id := helper.GetInstallerOperationID(commands); id != "installer-1760000000" {
+		t.Fatalf("expected operation installer-1760000000, got %s", id)
+	}
+	failed := helper.GetInstallerFailedCommands(commands, "install", false)
+	if len(failed) != 1 || failed[0] != "10.10.10.2: installSds failed package not found" {
+		t.Fatalf("unexpected failed commands %v", failed)
+	}
+
+	// only an operation targeting the configured nodes is resumed
+	operation := &helper.InstallerOperation{ID: "installer-1760000000", NodeIPs: helper.GetInstallerNodeIPs(commands)}
+	nodes := []models.ClusterModel{{IP: types.StringValue("10.10.10.1")}, {SDSAllIPs: types.StringValue("10.10.10.3,10.10.10.2")}}
+	if !helper.IsInstallerOperationOfNodes(operation, nodes) {
+		t.Fatalf("expected operation of nodes %v to be resumed", operation.NodeIPs)
+	}
+	if helper.IsInstallerOperationOfNodes(operation, nodes[:1]) {
+		t.Fatalf("expected operation of nodes %v not to be resumed", operation.NodeIPs)
+	}
+
+	// components are installed on the nodes from the install phase, which must be uninstalled on rollback
+	operation.Phase = "upload"
+	if helper.IsInstallerOperationInstalling(operation) {
+		t.Fatal("expected nothing installed before the install phase")
+	}
+	operation.Phase = "install"
+	if !helper.IsInstallerOperationInstalling(operation) {
+		t.Fatal("expected components installed in the install phase")
+	}
+}
+
 var packageTest = `
 resource "powerflex_package" "upload-test" {
 	file_path = ["../resource-test/powerflex_packages/EMC-ScaleIO-lia-3.6-700.103.Ubuntu.22.04.x86_64.tar"]
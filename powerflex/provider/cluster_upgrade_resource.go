@@ -0,0 +1,315 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &clusterUpgradeResource{}
+	_ resource.ResourceWithConfigure  = &clusterUpgradeResource{}
+	_ resource.ResourceWithModifyPlan = &clusterUpgradeResource{}
+)
+
+// NewClusterUpgradeResource - function to return resource interface
+func NewClusterUpgradeResource() resource.Resource {
+	return &clusterUpgradeResource{}
+}
+
+// clusterUpgradeResource - struct to define cluster upgrade resource
+type clusterUpgradeResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for cluster upgrade resource.
+func (r *clusterUpgradeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_upgrade"
+}
+
+// Schema - function to return Schema for cluster upgrade resource.
+func (r *clusterUpgradeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ClusterUpgradeResourceSchema
+}
+
+// Configure - function to return Configuration for cluster upgrade resource.
+func (r *clusterUpgradeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// ModifyPlan - function to plan the upgrade again when the components have drifted from the target version.
+func (r *clusterUpgradeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is getting created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.ClusterUpgradeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !helper.Known(plan.TargetVersion, state.Components) {
+		return
+	}
+
+	// the components in the state are refreshed by read
+	components, diags := helper.GetClusterUpgradeComponents(ctx, state.Components)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := plan.TargetVersion.ValueString()
+	if newer := helper.GetClusterComponentsNewerThan(components, target); len(newer) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_version"),
+			"Cluster downgrade is not supported",
+			"The following components are newer than the target version "+target+": "+strings.Join(newer, ", "),
+		)
+		return
+	}
+	for _, component := range components {
+		if !helper.ClusterVersionMatches(component.Version, target) {
+			tflog.Info(ctx, "Component is not at the target version, planning the upgrade", map[string]interface{}{"component": component.Name, "version": component.Version})
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("system_version"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("steps"), types.ListUnknown(types.ObjectType{AttrTypes: helper.ClusterUpgradeStepAttrTypes}))...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("components"), types.ListUnknown(types.ObjectType{AttrTypes: helper.ClusterUpgradeComponentAttrTypes}))...)
+			return
+		}
+	}
+}
+
+// Create - function to upgrade the cluster to the target version.
+func (r *clusterUpgradeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.ClusterUpgradeResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	steps, diags := r.upgrade(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.read(plan, steps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the versions of the components of the cluster.
+func (r *clusterUpgradeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.ClusterUpgradeResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = r.read(state, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to upgrade the cluster to the updated target version.
+// The upgrade is run again as well when the components have drifted from the target version, as planned by ModifyPlan.
+func (r *clusterUpgradeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan models.ClusterUpgradeResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	steps, diags := r.upgrade(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.read(plan, steps)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to remove the cluster upgrade from the state.
+// The cluster is not downgraded.
+func (r *clusterUpgradeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	resp.State.RemoveResource(ctx)
+}
+
+// upgrade runs the steps of the rolling upgrade, skipping the steps whose components are already at the target version
+func (r *clusterUpgradeResource) upgrade(ctx context.Context, plan models.ClusterUpgradeResourceModel) ([]models.ClusterUpgradeStepModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	target := plan.TargetVersion.ValueString()
+
+	if err := helper.RenewInstallationCookie(r.gatewayClient); err != nil {
+		diags.AddWarning(
+			"Error for renewing installation cookie.",
+			"unexpected error: "+err.Error(),
+		)
+	}
+
+	_, components, err := helper.GetClusterComponentVersions(r.client)
+	if err != nil {
+		diags.AddError("Error in getting the versions of the cluster components", err.Error())
+		return nil, diags
+	}
+
+	if newer := helper.GetClusterComponentsNewerThan(components, target); len(newer) > 0 {
+		diags.AddError(
+			"Cluster downgrade is not supported",
+			"The following components are newer than the target version "+target+": "+strings.Join(newer, ", "),
+		)
+		return nil, diags
+	}
+
+	steps := helper.GetClusterUpgradeSteps(components)
+	pendingSteps := 0
+	for _, step := range steps {
+		if helper.IsClusterUpgradeStepPending(step, target) {
+			pendingSteps++
+		}
+	}
+
+	results := make([]models.ClusterUpgradeStepModel, 0, len(steps))
+	if pendingSteps == 0 {
+		tflog.Info(ctx, "All the cluster components are at the target version", map[string]interface{}{"target_version": target})
+		for _, step := range steps {
+			results = append(results, newClusterUpgradeStepModel(step, helper.ClusterUpgradeStepSkipped))
+		}
+		return results, diags
+	}
+
+	if err := helper.ValidateUpgradePackages(r.gatewayClient, target); err != nil {
+		diags.AddError("Error in validating the upgrade packages", err.Error())
+		return nil, diags
+	}
+
+	clusterModel := models.ClusterResourceModel{
+		MdmPassword:                        plan.MdmPassword,
+		LiaPassword:                        plan.LiaPassword,
+		AllowNonSecureCommunicationWithMdm: plan.AllowNonSecureCommunicationWithMdm,
+		AllowNonSecureCommunicationWithLia: plan.AllowNonSecureCommunicationWithLia,
+		DisableNonMgmtComponentsAuth:       plan.DisableNonMgmtComponentsAuth,
+	}
+	topology, err := helper.GetClusterDetails(clusterModel, r.gatewayClient, plan.MdmIP.ValueString(), true)
+	if err != nil {
+		diags.AddError("Error in getting the cluster topology", err.Error())
+		return nil, diags
+	}
+
+	timeout := time.Duration(plan.RebuildRebalanceTimeout.ValueInt64()) * time.Minute
+	completedSteps := 0
+	for _, step := range steps {
+		if !helper.IsClusterUpgradeStepPending(step, target) {
+			tflog.Info(ctx, "Skipping upgrade step, the components are at the target version", map[string]interface{}{"step": step.Name})
+			results = append(results, newClusterUpgradeStepModel(step, helper.ClusterUpgradeStepSkipped))
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Upgrading %s, step %d of %d", step.Name, completedSteps+1, pendingSteps))
+		if step.Type == "SDS" {
+			if err := helper.WaitForRebuildRebalance(ctx, r.client, timeout); err != nil {
+				diags.AddError("Error in upgrading "+step.Name, clusterUpgradeResumeDetail(err))
+				return nil, diags
+			}
+		}
+
+		stepTopology, err := helper.GetClusterUpgradeTopology(topology.Data, step)
+		if err == nil {
+			err = helper.RunClusterUpgradeStep(ctx, r.gatewayClient, r.client, plan, stepTopology)
+		}
+		if err == nil && step.Type == "SDS" {
+			err = helper.WaitForRebuildRebalance(ctx, r.client, timeout)
+		}
+		if err != nil {
+			diags.AddError("Error in upgrading "+step.Name, clusterUpgradeResumeDetail(err))
+			return nil, diags
+		}
+
+		completedSteps++
+		tflog.Info(ctx, fmt.Sprintf("Upgraded %s, %d of %d steps completed", step.Name, completedSteps, pendingSteps))
+		results = append(results, newClusterUpgradeStepModel(step, helper.ClusterUpgradeStepCompleted))
+	}
+	return results, diags
+}
+
+// read gets the versions of the components of the cluster
+func (r *clusterUpgradeResource) read(plan models.ClusterUpgradeResourceModel, steps []models.ClusterUpgradeStepModel) (models.ClusterUpgradeResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	systemVersion, components, err := helper.GetClusterComponentVersions(r.client)
+	if err != nil {
+		diags.AddError("Error in getting the versions of the cluster components", err.Error())
+		return plan, diags
+	}
+	return helper.UpdateClusterUpgradeState(plan, systemVersion, components, steps)
+}
+
+func newClusterUpgradeStepModel(step helper.ClusterUpgradeStep, status string) models.ClusterUpgradeStepModel {
+	return models.ClusterUpgradeStepModel{
+		Name:   types.StringValue(step.Name),
+		Status: types.StringValue(status),
+	}
+}
+
+func clusterUpgradeResumeDetail(err error) string {
+	return err.Error() + ". Apply again to resume the upgrade, the components which are already at the target version are skipped."
+}
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ClusterUpgradeResourceSchema defines the schema for cluster upgrade resource
+var ClusterUpgradeResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to perform a non-disruptive rolling upgrade of a PowerFlex Cluster using the packages uploaded to the gateway." +
+		" The MDMs are upgraded first, then the SDSs one fault set at a time, waiting for rebuild and rebalance to complete between the steps, and then the SDCs." +
+		" Components which are already at the target version are skipped, so a failed upgrade is resumed by applying again." +
+		" Components which have drifted from the target version are detected during plan and upgraded again." +
+		" This resource supports Create, Update and Delete operations. Delete only removes the resource from the state.",
+	MarkdownDescription: "This resource is used to perform a non-disruptive rolling upgrade of a PowerFlex Cluster using the packages uploaded to the gateway." +
+		" The MDMs are upgraded first, then the SDSs one fault set at a time, waiting for rebuild and rebalance to complete between the steps, and then the SDCs." +
+		" Components which are already at the target version are skipped, so a failed upgrade is resumed by applying again." +
+		" Components which have drifted from the target version are detected during plan and upgraded again." +
+		" This resource supports Create, Update and Delete operations. Delete only removes the resource from the state.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the cluster upgrade.",
+			MarkdownDescription: "ID of the cluster upgrade.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"mdm_ip": schema.StringAttribute{
+			Description:         "IP of the primary MDM of the cluster.",
+			MarkdownDescription: "IP of the primary MDM of the cluster.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"mdm_password": schema.StringAttribute{
+			Description:         "MDM Password",
+			MarkdownDescription: "MDM Password",
+			Required:            true,
+			Sensitive:           true,
+		},
+		"lia_password": schema.StringAttribute{
+			Description:         "Lia Password",
+			MarkdownDescription: "Lia Password",
+			Required:            true,
+			Sensitive:           true,
+		},
+		"allow_non_secure_communication_with_mdm": schema.BoolAttribute{
+			Description:         "Allow Non Secure Communication With MDM",
+			MarkdownDescription: "Allow Non Secure Communication With MDM",
+			Optional:            true,
+		},
+		"allow_non_secure_communication_with_lia": schema.BoolAttribute{
+			Description:         "Allow Non Secure Communication With lia",
+			MarkdownDescription: "Allow Non Secure Communication With lia",
+			Optional:            true,
+		},
+		"disable_non_mgmt_components_auth": schema.BoolAttribute{
+			Description:         "Disable Non Mgmt Components Auth",
+			MarkdownDescription: "Disable Non Mgmt Components Auth",
+			Optional:            true,
+		},
+		"target_version": schema.StringAttribute{
+			Description: "Version to which the cluster is upgraded, for example `4.5.2100.105`." +
+				" A component is considered at the target version if the components of its version match all the components of the target version, so the target `4.5.2100` matches `4.5.2100.105` but the target `4.5.2` does not match `4.5.2100.0`." +
+				" Downgrading the cluster is not supported." +
+				" Packages of this version must be uploaded to the gateway.",
+			MarkdownDescription: "Version to which the cluster is upgraded, for example `4.5.2100.105`." +
+				" A component is considered at the target version if the components of its version match all the components of the target version, so the target `4.5.2100` matches `4.5.2100.105` but the target `4.5.2` does not match `4.5.2100.0`." +
+				" Downgrading the cluster is not supported." +
+				" Packages of this version must be uploaded to the gateway.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"rebuild_rebalance_timeout_in_minutes": schema.Int64Attribute{
+			Description:         "Maximum time to wait for rebuild and rebalance to complete before and after the upgrade of each fault set. Default value is `60`.",
+			MarkdownDescription: "Maximum time to wait for rebuild and rebalance to complete before and after the upgrade of each fault set. Default value is `60`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(60),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"system_version": schema.StringAttribute{
+			Description:         "Version of the PowerFlex system.",
+			MarkdownDescription: "Version of the PowerFlex system.",
+			Computed:            true,
+		},
+		"steps": schema.ListNestedAttribute{
+			Description:         "Steps of the last upgrade, in the order in which they were run.",
+			MarkdownDescription: "Steps of the last upgrade, in the order in which they were run.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "Name of the step.",
+						MarkdownDescription: "Name of the step.",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						Description:         "Status of the step, `Completed` or `Skipped` if all the components were already at the target version.",
+						MarkdownDescription: "Status of the step, `Completed` or `Skipped` if all the components were already at the target version.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"components": schema.ListNestedAttribute{
+			Description:         "Versions of the MDMs, SDSs and SDCs of the cluster.",
+			MarkdownDescription: "Versions of the MDMs, SDSs and SDCs of the cluster.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description:         "Type of the component, `MDM`, `SDS` or `SDC`.",
+						MarkdownDescription: "Type of the component, `MDM`, `SDS` or `SDC`.",
+						Computed:            true,
+					},
+					"id": schema.StringAttribute{
+						Description:         "ID of the component.",
+						MarkdownDescription: "ID of the component.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the component.",
+						MarkdownDescription: "Name of the component.",
+						Computed:            true,
+					},
+					"ip": schema.StringAttribute{
+						Description:         "IP of the component.",
+						MarkdownDescription: "IP of the component.",
+						Computed:            true,
+					},
+					"fault_set_id": schema.StringAttribute{
+						Description:         "ID of the fault set of the SDS.",
+						MarkdownDescription: "ID of the fault set of the SDS.",
+						Computed:            true,
+					},
+					"version": schema.StringAttribute{
+						Description:         "Software version of the component.",
+						MarkdownDescription: "Software version of the component.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
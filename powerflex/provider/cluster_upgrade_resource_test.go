@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	goscaleio_types "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var clusterUpgradeConfig = `
+resource "powerflex_cluster_upgrade" "test" {
+	mdm_ip = "10.10.10.1"
+	mdm_password = "Password"
+	lia_password = "Password"
+	allow_non_secure_communication_with_lia = true
+	allow_non_secure_communication_with_mdm = true
+	target_version = "4.5.2100"
+}
+`
+
+var clusterUpgradeInvalidTimeoutConfig = `
+resource "powerflex_cluster_upgrade" "test" {
+	mdm_ip = "10.10.10.1"
+	mdm_password = "Password"
+	lia_password = "Password"
+	target_version = "4.5.2100"
+	rebuild_rebalance_timeout_in_minutes = 0
+}
+`
+
+var clusterUpgradeOutdatedComponentsMock = []helper.ClusterComponentVersion{
+	{Type: "MDM", ID: "mdm1", Name: "mdm1", IP: "10.10.10.1", Version: "R4_5.2100.0"},
+	{Type: "SDS", ID: "sds1", Name: "sds1", IP: "10.10.10.2", FaultSetID: "fs1", Version: "R4_5.1000.0"},
+	{Type: "SDS", ID: "sds2", Name: "sds2", IP: "10.10.10.3", FaultSetID: "fs2", Version: "R4_5.2100.0"},
+	{Type: "SDC", ID: "sdc1", Name: "sdc1", IP: "10.10.10.4", Version: "R4_5.2100.0"},
+}
+
+// UT
+func TestAccResourceClusterUpgradeUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var packagesMocker, topologyMocker, stepMocker, waitMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Timeout must be at least one minute
+			{
+				Config:      ProviderConfigForTesting + clusterUpgradeInvalidTimeoutConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute rebuild_rebalance_timeout_in_minutes value must be at least 1*.`),
+			},
+			// Error getting the versions of the components
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetClusterComponentVersions).Return("", nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + clusterUpgradeConfig,
+				ExpectError: regexp.MustCompile(`.*Error in getting the versions of the cluster components*.`),
+			},
+			// Packages of the target version are not uploaded
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetClusterComponentVersions).Return("R4_5.1000.0", clusterUpgradeOutdatedComponentsMock, nil).Build()
+					packagesMocker = Mock(helper.ValidateUpgradePackages).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + clusterUpgradeConfig,
+				ExpectError: regexp.MustCompile(`.*Error in validating the upgrade packages*.`),
+			},
+			// Error in the upgrade of a fault set
+			{
+				PreConfig: func() {
+					if packagesMocker != nil {
+						packagesMocker.UnPatch()
+					}
+					packagesMocker = Mock(helper.ValidateUpgradePackages).Return(nil).Build()
+					topologyMocker = Mock(helper.GetClusterDetails).Return(&goscaleio_types.GatewayResponse{Data: `{"sdsList":[{"id":"sds1"}]}`}, nil).Build()
+					waitMocker = Mock(helper.WaitForRebuildRebalance).Return(nil).Build()
+					stepMocker = Mock(helper.RunClusterUpgradeStep).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + clusterUpgradeConfig,
+				ExpectError: regexp.MustCompile(`.*Error in upgrading SDS \(fault set fs1\)*.`),
+			},
+			// Upgrade the outdated fault set, the other steps are skipped
+			{
+				PreConfig: func() {
+					if stepMocker != nil {
+						stepMocker.UnPatch()
+					}
+					stepMocker = Mock(helper.RunClusterUpgradeStep).Return(nil).Build()
+				},
+				Config: ProviderConfigForTesting + clusterUpgradeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "system_version", "R4_5.1000.0"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "steps.#", "4"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "steps.0.status", "Skipped"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "steps.1.name", "SDS (fault set fs1)"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "steps.1.status", "Completed"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "steps.2.status", "Skipped"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "components.#", "4"),
+					resource.TestCheckResourceAttr("powerflex_cluster_upgrade.test", "components.1.fault_set_id", "fs1"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			for _, mocker := range []*Mocker{packagesMocker, topologyMocker, stepMocker, waitMocker} {
+				if mocker != nil {
+					mocker.UnPatch()
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// TestClusterUpgradeSteps tests the ordering of the rolling upgrade and the topology of each step
+func TestClusterUpgradeSteps(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	steps := helper.GetClusterUpgradeSteps(clusterUpgradeOutdatedComponentsMock)
+	names := []string{"MDM", "SDS (fault set fs1)", "SDS (fault set fs2)", "SDC"}
+	if len(steps) != len(names) {
+		t.Fatalf("expected %d steps, got %d", len(names), len(steps))
+	}
+	for i, name := range names {
+		if steps[i].Name != name {
+			t.Fatalf("expected step %d to be %s, got %s", i, name, steps[i].Name)
+		}
+	}
+	if helper.IsClusterUpgradeStepPending(steps[0], "4.5.2100") || !helper.IsClusterUpgradeStepPending(steps[1], "4.5.2100") {
+		t.Fatal("expected only the SDS of fault set fs1 to be pending")
+	}
+
+	topology, err := helper.GetClusterUpgradeTopology(`{"sdsList":[{"id":"sds1"},{"id":"sds2"}],"sdcList":[{"guid":"1"}]}`, steps[1])
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if len(topology["sdsList"].([]interface{})) != 1 || len(topology["sdcList"].([]interface{})) != 0 {
+		t.Fatalf("expected the topology to contain only the SDS of fault set fs1, got %v", topology)
+	}
+}
+
+// TestClusterVersionMatches tests the comparison of the component versions with the target version
+func TestClusterVersionMatches(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	tests := []struct {
+		version string
+		target  string
+		compare int
+	}{
+		{"R4_5.2100.105", "4.5.2100", 0},
+		{"4.5.2100.0", "4.5.2", 1},
+		{"4.5.2.0", "4.5.2100", -1},
+		{"4.5", "4.5.2100", -1},
+		{"4.6.0.100", "4.5.2100", 1},
+	}
+	for _, tt := range tests {
+		if c := helper.CompareClusterVersions(tt.version, tt.target); c != tt.compare {
+			t.Errorf("CompareClusterVersions(%s, %s) = %d, want %d", tt.version, tt.target, c, tt.compare)
+		}
+	}
+	if helper.ClusterVersionMatches("4.5.2100.0", "4.5.2") {
+		t.Error("expected 4.5.2100.0 not to match the target 4.5.2")
+	}
+	newer := helper.GetClusterComponentsNewerThan([]helper.ClusterComponentVersion{
+		{Type: "MDM", Name: "mdm1", Version: "R4_5.2100.105"},
+		{Type: "SDS", Name: "sds1", Version: "R4_5.1000.0"},
+	}, "4.5.1000")
+	if len(newer) != 1 {
+		t.Errorf("expected only mdm1 to be newer than the target version, got %v", newer)
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &discoveryResource{}
+	_ resource.ResourceWithConfigure = &discoveryResource{}
+)
+
+// NewDiscoveryResource - function to return resource interface
+func NewDiscoveryResource() resource.Resource {
+	return &discoveryResource{}
+}
+
+// discoveryResource - struct to define discovery resource
+type discoveryResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for discovery resource.
+func (r *discoveryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discovery"
+}
+
+// Schema - function to return Schema for discovery resource.
+func (r *discoveryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = DiscoveryResourceSchema
+}
+
+// Configure - function to return Configuration for discovery resource.
+func (r *discoveryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// Create - function to run the discovery job and wait for its completion.
+func (r *discoveryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.DiscoveryResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := helper.GetDiscoveryRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the devices already in the inventory are not removed on destroy
+	inventory, err := helper.GetInventoryRefIDs(r.gatewayClient)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading the inventory before the discovery",
+			err.Error(),
+		)
+		return
+	}
+
+	id, err := helper.StartDiscovery(r.gatewayClient, r.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error starting the discovery job",
+			err.Error(),
+		)
+		return
+	}
+
+	timeout := time.Duration(plan.Timeout.ValueInt64()) * time.Minute
+	result, waitErr := helper.WaitForDiscovery(ctx, r.gatewayClient, r.client, id, timeout)
+	if waitErr == nil && (strings.EqualFold(result.Status, "ERROR") || strings.EqualFold(result.Status, "FAILED")) {
+		resp.Diagnostics.AddError(
+			"Error in the discovery job",
+			fmt.Sprintf("discovery job %s has failed: %s", id, result.StatusMessage),
+		)
+		return
+	}
+
+	// the discovery job still running is saved in the state before reporting the error, so that it is not orphaned
+	if result == nil {
+		result = &helper.DiscoveryResult{ID: id}
+	}
+	state, diags := helper.UpdateDiscoveryState(plan, result)
+	resp.Diagnostics.Append(diags...)
+	addedRefIDs, diags := types.ListValueFrom(ctx, types.StringType, helper.GetAddedRefIDs(inventory, result))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.AddedRefIDs = addedRefIDs
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			"Error waiting for the discovery job",
+			waitErr.Error(),
+		)
+	}
+}
+
+// Read - function to read the discovery job and its devices.
+func (r *discoveryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.DiscoveryResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := helper.GetDiscoveryResult(r.gatewayClient, r.client, state.ID.ValueString())
+	if helper.IsGatewayNotFoundError(err) {
+		tflog.Info(ctx, "Discovery job not found, removing it from the state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting the discovery job",
+			err.Error(),
+		)
+		return
+	}
+
+	state, diags = helper.UpdateDiscoveryState(state, result)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to update the settings which do not require a new discovery job.
+func (r *discoveryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan, state models.DiscoveryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only timeout and remove_devices_on_destroy can be updated in place
+	state.Timeout = plan.Timeout
+	state.RemoveDevicesOnDestroy = plan.RemoveDevicesOnDestroy
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to remove the discovery from the state.
+// The devices added to the inventory by the discovery are removed only if remove_devices_on_destroy is set.
+func (r *discoveryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	var state models.DiscoveryResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.RemoveDevicesOnDestroy.ValueBool() {
+		var refIDs []string
+		resp.Diagnostics.Append(state.AddedRefIDs.ElementsAs(ctx, &refIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := helper.RemoveDiscoveredDevices(r.gatewayClient, r.client, refIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error removing the discovered devices",
+				err.Error(),
+			)
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
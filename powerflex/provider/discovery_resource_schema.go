@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DiscoveryResourceSchema defines the schema for discovery resource
+var DiscoveryResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to discover nodes, switches and vCenters in PowerFlex Manager." +
+		" The discovery job is run on the given IP ranges with a resource credential and the reference IDs of the discovered devices are exposed, so that they can be used by the resource group." +
+		" This resource supports Create and Delete operations. Any change of the discovery settings replaces the resource.",
+	MarkdownDescription: "This resource is used to discover nodes, switches and vCenters in PowerFlex Manager." +
+		" The discovery job is run on the given IP ranges with a resource credential and the reference IDs of the discovered devices are exposed, so that they can be used by the resource group." +
+		" This resource supports Create and Delete operations. Any change of the discovery settings replaces the resource.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the discovery job.",
+			MarkdownDescription: "ID of the discovery job.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ip_ranges": schema.ListNestedAttribute{
+			Description:         "IP ranges to discover.",
+			MarkdownDescription: "IP ranges to discover.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"start_ip": schema.StringAttribute{
+						Description:         "First IP of the range.",
+						MarkdownDescription: "First IP of the range.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"end_ip": schema.StringAttribute{
+						Description:         "Last IP of the range. If not specified, only the first IP is discovered.",
+						MarkdownDescription: "Last IP of the range. If not specified, only the first IP is discovered.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"resource_type": schema.StringAttribute{
+			Description:         "Type of the resources to discover. Accepted values are `Node`, `Switch` and `vCenter`.",
+			MarkdownDescription: "Type of the resources to discover. Accepted values are `Node`, `Switch` and `vCenter`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("Node", "Switch", "vCenter"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"credential_id": schema.StringAttribute{
+			Description:         "ID of the resource credential used to discover the resources. The type of the credential must match `resource_type`.",
+			MarkdownDescription: "ID of the resource credential used to discover the resources. The type of the credential must match `resource_type`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			Description:         "Describes the time in minutes to timeout the discovery job.",
+			MarkdownDescription: "Describes the time in minutes to timeout the discovery job.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(15),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"remove_devices_on_destroy": schema.BoolAttribute{
+			Description:         "Whether to remove the discovered devices from the inventory of PowerFlex Manager on destroy. Only the devices added to the inventory by the discovery, listed in `added_ref_ids`, are removed. Default value is `false`.",
+			MarkdownDescription: "Whether to remove the discovered devices from the inventory of PowerFlex Manager on destroy. Only the devices added to the inventory by the discovery, listed in `added_ref_ids`, are removed. Default value is `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"status": schema.StringAttribute{
+			Description:         "Status of the discovery job.",
+			MarkdownDescription: "Status of the discovery job.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ref_ids": schema.ListAttribute{
+			Description:         "Reference IDs of the discovered devices.",
+			MarkdownDescription: "Reference IDs of the discovered devices.",
+			Computed:            true,
+			ElementType:         types.StringType,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"added_ref_ids": schema.ListAttribute{
+			Description:         "Reference IDs of the discovered devices which were not in the inventory before the discovery.",
+			MarkdownDescription: "Reference IDs of the discovered devices which were not in the inventory before the discovery.",
+			Computed:            true,
+			ElementType:         types.StringType,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"discovered_devices": schema.ListNestedAttribute{
+			Description:         "Devices found by the discovery job.",
+			MarkdownDescription: "Devices found by the discovery job.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"ref_id": schema.StringAttribute{
+						Description:         "Reference ID of the device.",
+						MarkdownDescription: "Reference ID of the device.",
+						Computed:            true,
+					},
+					"ip_address": schema.StringAttribute{
+						Description:         "IP address of the device.",
+						MarkdownDescription: "IP address of the device.",
+						Computed:            true,
+					},
+					"device_type": schema.StringAttribute{
+						Description:         "Type of the device.",
+						MarkdownDescription: "Type of the device.",
+						Computed:            true,
+					},
+					"service_tag": schema.StringAttribute{
+						Description:         "Service tag of the device.",
+						MarkdownDescription: "Service tag of the device.",
+						Computed:            true,
+					},
+					"model": schema.StringAttribute{
+						Description:         "Model of the device.",
+						MarkdownDescription: "Model of the device.",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						Description:         "Discovery status of the device.",
+						MarkdownDescription: "Discovery status of the device.",
+						Computed:            true,
+					},
+					"status_message": schema.StringAttribute{
+						Description:         "Discovery status message of the device.",
+						MarkdownDescription: "Discovery status message of the device.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
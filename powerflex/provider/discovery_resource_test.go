@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	goscaleio_types "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var discoveryConfig = `
+resource "powerflex_discovery" "test" {
+	ip_ranges = [
+		{
+			start_ip = "10.10.10.11"
+			end_ip = "10.10.10.12"
+		}
+	]
+	resource_type = "Node"
+	credential_id = "8aaa80658cd602e0018cda8b257f78ce"
+}
+`
+
+var discoveryInvalidTypeConfig = `
+resource "powerflex_discovery" "test" {
+	ip_ranges = [
+		{
+			start_ip = "10.10.10.11"
+		}
+	]
+	resource_type = "OSAdmin"
+	credential_id = "8aaa80658cd602e0018cda8b257f78ce"
+}
+`
+
+var discoveryResultMock = &helper.DiscoveryResult{
+	ID:     "8aaa03a88de961fa018de97b9c2a0d5f",
+	Status: "SUCCESS",
+	Devices: []helper.DiscoveredDevice{
+		{RefID: "scaleio-block-legacy-gateway-1", IPAddress: "10.10.10.11", DeviceType: "RackServer", Status: "SUCCESS"},
+		{RefID: "scaleio-block-legacy-gateway-2", IPAddress: "10.10.10.12", DeviceType: "RackServer", Status: "SUCCESS"},
+	},
+}
+
+// AT
+func TestAccResourceDiscovery(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Discover nodes
+			{
+				Config: ProviderConfigForTesting + discoveryConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "resource_type", "Node"),
+					resource.TestCheckResourceAttrSet("powerflex_discovery.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+// UT
+func TestAccResourceDiscoveryUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var inventoryMocker, waitMocker, readMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Only nodes, switches and vCenters can be discovered
+			{
+				Config:      ProviderConfigForTesting + discoveryInvalidTypeConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute resource_type value must be one of*.`),
+			},
+			// Error starting the discovery job
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.StartDiscovery).Return("", fmt.Errorf("Mock error")).Build()
+					inventoryMocker = Mock(helper.GetInventoryRefIDs).Return(map[string]bool{"scaleio-block-legacy-gateway-2": true}, nil).Build()
+				},
+				Config:      ProviderConfigForTesting + discoveryConfig,
+				ExpectError: regexp.MustCompile(`.*Error starting the discovery job*.`),
+			},
+			// Discovery job has failed
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.StartDiscovery).Return(discoveryResultMock.ID, nil).Build()
+					waitMocker = Mock(helper.WaitForDiscovery).Return(&helper.DiscoveryResult{
+						ID:            discoveryResultMock.ID,
+						Status:        "ERROR",
+						StatusMessage: "Mock error",
+					}, nil).Build()
+				},
+				Config:      ProviderConfigForTesting + discoveryConfig,
+				ExpectError: regexp.MustCompile(`.*Error in the discovery job*.`),
+			},
+			// Discovery job is not completed before the timeout, it is saved in the state and replaced by the next apply
+			{
+				PreConfig: func() {
+					if waitMocker != nil {
+						waitMocker.UnPatch()
+					}
+					waitMocker = Mock(helper.WaitForDiscovery).Return(&helper.DiscoveryResult{
+						ID:     discoveryResultMock.ID,
+						Status: "INPROGRESS",
+					}, fmt.Errorf("discovery job %s is not completed after 1m0s", discoveryResultMock.ID)).Build()
+				},
+				Config:      ProviderConfigForTesting + discoveryConfig,
+				ExpectError: regexp.MustCompile(`.*Error waiting for the discovery job*.`),
+			},
+			// Discover nodes
+			{
+				PreConfig: func() {
+					if waitMocker != nil {
+						waitMocker.UnPatch()
+					}
+					waitMocker = Mock(helper.WaitForDiscovery).Return(discoveryResultMock, nil).Build()
+					readMocker = Mock(helper.GetDiscoveryResult).Return(discoveryResultMock, nil).Build()
+				},
+				Config: ProviderConfigForTesting + discoveryConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "id", discoveryResultMock.ID),
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "ref_ids.#", "2"),
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "ref_ids.0", "scaleio-block-legacy-gateway-1"),
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "added_ref_ids.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "added_ref_ids.0", "scaleio-block-legacy-gateway-1"),
+					resource.TestCheckResourceAttr("powerflex_discovery.test", "discovered_devices.1.ip_address", "10.10.10.12"),
+				),
+			},
+			// Discovery job is not found anymore, it is removed from the state and created again
+			{
+				PreConfig: func() {
+					if readMocker != nil {
+						readMocker.UnPatch()
+					}
+					readMocker = Mock(helper.GetDiscoveryResult).Return(nil, &goscaleio_types.Error{HTTPStatusCode: 404, Message: "not found"}).Build()
+				},
+				Config:             ProviderConfigForTesting + discoveryConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if inventoryMocker != nil {
+				inventoryMocker.UnPatch()
+			}
+			if waitMocker != nil {
+				waitMocker.UnPatch()
+			}
+			if readMocker != nil {
+				readMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &firmwareRepositoryCompareDataSource{}
+	_ datasource.DataSourceWithConfigure = &firmwareRepositoryCompareDataSource{}
+)
+
+// FirmwareRepositoryCompareDataSource returns the firmware repository compare data source
+func FirmwareRepositoryCompareDataSource() datasource.DataSource {
+	return &firmwareRepositoryCompareDataSource{}
+}
+
+type firmwareRepositoryCompareDataSource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+func (d *firmwareRepositoryCompareDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firmware_repository_compare"
+}
+
+func (d *firmwareRepositoryCompareDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = FirmwareRepositoryCompareDataSourceSchema
+}
+
+func (d *firmwareRepositoryCompareDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client != nil {
+
+		d.client = req.ProviderData.(*powerflexProvider).client
+	}
+
+	if req.ProviderData.(*powerflexProvider).gatewayClient != nil {
+
+		d.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+	} else {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *firmwareRepositoryCompareDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Started firmware repository compare data source read method")
+	var state models.FirmwareRepositoryCompareDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := d.gatewayClient.GetUploadComplianceDetailsUsingID(state.TargetRepositoryID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting the target firmware repository", err.Error(),
+		)
+		return
+	}
+
+	var diffs []models.FirmwareComponentDiffModel
+	if state.SourceRepositoryID.ValueString() != "" {
+		var source *scaleiotypes.FirmwareRepositoryDetails
+		source, err = d.gatewayClient.GetUploadComplianceDetailsUsingID(state.SourceRepositoryID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting the source firmware repository", err.Error(),
+			)
+			return
+		}
+		state.SourceName = types.StringValue(source.Name)
+		diffs = helper.CompareFirmwareRepositories(source, target, state.IncludeUnchanged.ValueBool())
+	} else {
+		var deployment *scaleiotypes.ServiceResponse
+		deployment, err = d.gatewayClient.GetServiceDetailsByID(state.ResourceGroupID.ValueString(), false)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting resource group details", err.Error(),
+			)
+			return
+		}
+		var reports []scaleiotypes.ComplianceReport
+		reports, err = d.gatewayClient.GetServiceComplianceDetails(state.ResourceGroupID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting the compliance of the resource group", err.Error(),
+			)
+			return
+		}
+		// the components of the compliance report are resolved in the firmware repository of the resource group
+		repositoryID := deployment.FirmwareRepositoryID
+		if repositoryID == "" {
+			repositoryID = deployment.FirmwareRepository.ID
+		}
+		var source *scaleiotypes.FirmwareRepositoryDetails
+		source, err = d.gatewayClient.GetUploadComplianceDetailsUsingID(repositoryID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting the firmware repository of the resource group", err.Error(),
+			)
+			return
+		}
+		state.SourceName = types.StringValue(deployment.DeploymentName)
+		diffs, err = helper.CompareResourceGroupFirmware(reports, source, target, state.IncludeUnchanged.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in comparing the resource group with the firmware repository", err.Error(),
+			)
+			return
+		}
+	}
+
+	upgrades, downgrades, missing := helper.GetFirmwareDiffCounts(diffs)
+	state.TargetRepositoryName = types.StringValue(target.Name)
+	state.UpgradeCount = types.Int64Value(upgrades)
+	state.DowngradeCount = types.Int64Value(downgrades)
+	state.MissingCount = types.Int64Value(missing)
+	state.ComponentDiffs = diffs
+	state.ID = types.StringValue("firmware-repository-compare-datasource-id")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
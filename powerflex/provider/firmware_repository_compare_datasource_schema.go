@@ -0,0 +1,139 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// FirmwareRepositoryCompareDataSourceSchema defines the schema for firmware repository compare datasource
+var FirmwareRepositoryCompareDataSourceSchema schema.Schema = schema.Schema{
+	Description: "This datasource is used to compare a firmware repository of PowerFlex Manager with another firmware repository or with the components installed on the nodes of a resource group." +
+		" The components are compared one by one to review the versions which would be upgraded, downgraded or are missing before switching the catalog or remediating the resource group.",
+	MarkdownDescription: "This datasource is used to compare a firmware repository of PowerFlex Manager with another firmware repository or with the components installed on the nodes of a resource group." +
+		" The components are compared one by one to review the versions which would be upgraded, downgraded or are missing before switching the catalog or remediating the resource group.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "default datasource id",
+			MarkdownDescription: "default datasource id",
+			Computed:            true,
+		},
+		"target_repository_id": schema.StringAttribute{
+			Description:         "ID of the firmware repository to compare with, for example the catalog to switch to.",
+			MarkdownDescription: "ID of the firmware repository to compare with, for example the catalog to switch to.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"source_repository_id": schema.StringAttribute{
+			Description:         "ID of the firmware repository to compare, for example the current default catalog. Conflicts with resource_group_id.",
+			MarkdownDescription: "ID of the firmware repository to compare, for example the current default catalog. Conflicts with `resource_group_id`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("resource_group_id")),
+			},
+		},
+		"resource_group_id": schema.StringAttribute{
+			Description:         "ID of the resource group whose installed components are compared. The components of the nodes are resolved in the firmware repository of the resource group and matched with the components of the target repository by component, device and vendor IDs. Conflicts with source_repository_id.",
+			MarkdownDescription: "ID of the resource group whose installed components are compared. The components of the nodes are resolved in the firmware repository of the resource group and matched with the components of the target repository by component, device and vendor IDs. Conflicts with `source_repository_id`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"include_unchanged": schema.BoolAttribute{
+			Description:         "Whether the components whose version is the same in the target repository are listed. Default value is false.",
+			MarkdownDescription: "Whether the components whose version is the same in the target repository are listed. Default value is `false`.",
+			Optional:            true,
+		},
+		"target_repository_name": schema.StringAttribute{
+			Description:         "Name of the target firmware repository.",
+			MarkdownDescription: "Name of the target firmware repository.",
+			Computed:            true,
+		},
+		"source_name": schema.StringAttribute{
+			Description:         "Name of the source firmware repository or of the resource group.",
+			MarkdownDescription: "Name of the source firmware repository or of the resource group.",
+			Computed:            true,
+		},
+		"upgrade_count": schema.Int64Attribute{
+			Description:         "Number of components which would be upgraded.",
+			MarkdownDescription: "Number of components which would be upgraded.",
+			Computed:            true,
+		},
+		"downgrade_count": schema.Int64Attribute{
+			Description:         "Number of components which would be downgraded.",
+			MarkdownDescription: "Number of components which would be downgraded.",
+			Computed:            true,
+		},
+		"missing_count": schema.Int64Attribute{
+			Description:         "Number of components which are missing in the target repository.",
+			MarkdownDescription: "Number of components which are missing in the target repository.",
+			Computed:            true,
+		},
+		"component_diffs": schema.ListNestedAttribute{
+			Description:         "Differences of the components between the source and the target, sorted by node and name.",
+			MarkdownDescription: "Differences of the components between the source and the target, sorted by node and name.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "Name of the component.",
+						MarkdownDescription: "Name of the component.",
+						Computed:            true,
+					},
+					"component_type": schema.StringAttribute{
+						Description:         "Type of the component.",
+						MarkdownDescription: "Type of the component.",
+						Computed:            true,
+					},
+					"operating_system": schema.StringAttribute{
+						Description:         "Operating system of the component.",
+						MarkdownDescription: "Operating system of the component.",
+						Computed:            true,
+					},
+					"node": schema.StringAttribute{
+						Description:         "Service tag of the node of the resource group on which the component is installed. Empty when two repositories are compared.",
+						MarkdownDescription: "Service tag of the node of the resource group on which the component is installed. Empty when two repositories are compared.",
+						Computed:            true,
+					},
+					"source_version": schema.StringAttribute{
+						Description:         "Version of the component in the source repository or on the node. Empty for a component which is only part of the target repository.",
+						MarkdownDescription: "Version of the component in the source repository or on the node. Empty for a component which is only part of the target repository.",
+						Computed:            true,
+					},
+					"target_version": schema.StringAttribute{
+						Description:         "Version of the component in the target repository. Empty for a component which is missing in the target repository.",
+						MarkdownDescription: "Version of the component in the target repository. Empty for a component which is missing in the target repository.",
+						Computed:            true,
+					},
+					"change": schema.StringAttribute{
+						Description:         "Change of the component when switching to the target repository, one of upgrade, downgrade, missing, new or unchanged.",
+						MarkdownDescription: "Change of the component when switching to the target repository, one of `upgrade`, `downgrade`, `missing`, `new` or `unchanged`.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var firmwareRepositoryCompareConfig = `
+data "powerflex_firmware_repository_compare" "test" {
+	target_repository_id = "8aaa80658cd602e0018cd996a1c91bdc"
+	resource_group_id = "8aaa03a88de961fa018de96a88d80008"
+}
+`
+
+var firmwareRepositoryCompareSourceMock = &scaleiotypes.FirmwareRepositoryDetails{
+	Name: "Intelligent Catalog 45.373.00",
+	SoftwareComponents: []scaleiotypes.Component{
+		{ID: "bios-1", Name: "BIOS", ComponentID: "159", DellVersion: "1.9.2"},
+		{ID: "idrac-1", Name: "iDRAC", ComponentID: "25227", DellVersion: "7.00.00.171"},
+		{ID: "perc-1", Name: "PERC H755", ComponentID: "104299", DellVersion: "52.16.1-4405"},
+	},
+}
+
+var firmwareRepositoryCompareTargetMock = &scaleiotypes.FirmwareRepositoryDetails{
+	Name: "Intelligent Catalog 46.381.00",
+	SoftwareComponents: []scaleiotypes.Component{
+		{ID: "bios-2", Name: "BIOS", ComponentID: "159", DellVersion: "1.10.2"},
+		{ID: "idrac-2", Name: "iDRAC", ComponentID: "25227", DellVersion: "6.10.30.00"},
+		{ID: "nic-2", Name: "Mellanox NIC", ComponentID: "108271", DellVersion: "22.36.10.10"},
+	},
+	SoftwareBundles: []scaleiotypes.Bundle{
+		{SoftwareComponents: []scaleiotypes.Component{{ID: "bios-3", Name: "BIOS", ComponentID: "159", DellVersion: "1.10.2"}}},
+	},
+}
+
+// UT
+func TestAccDatasourceFirmwareRepositoryCompareUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var deploymentMocker, reportMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error getting the target repository
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*goscaleio.GatewayClient).GetUploadComplianceDetailsUsingID).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + firmwareRepositoryCompareConfig,
+				ExpectError: regexp.MustCompile(`.*Error in getting the target firmware repository*.`),
+			},
+			// Compare the components installed on the nodes of the resource group
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*goscaleio.GatewayClient).GetUploadComplianceDetailsUsingID).To(func(_ *goscaleio.GatewayClient, id string) (*scaleiotypes.FirmwareRepositoryDetails, error) {
+						if id == "8aaa80658cd602e0018cd996a1c91bdb" {
+							return firmwareRepositoryCompareSourceMock, nil
+						}
+						return firmwareRepositoryCompareTargetMock, nil
+					}).Build()
+					deploymentMocker = Mock((*goscaleio.GatewayClient).GetServiceDetailsByID).Return(&scaleiotypes.ServiceResponse{DeploymentName: "RG1", FirmwareRepositoryID: "8aaa80658cd602e0018cd996a1c91bdb"}, nil).Build()
+					reportMocker = Mock((*goscaleio.GatewayClient).GetServiceComplianceDetails).Return(resourceGroupRemediationReportsMock, nil).Build()
+				},
+				Config: ProviderConfigForTesting + firmwareRepositoryCompareConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_firmware_repository_compare.test", "source_name", "RG1"),
+					resource.TestCheckResourceAttr("data.powerflex_firmware_repository_compare.test", "target_repository_name", "Intelligent Catalog 46.381.00"),
+					resource.TestCheckResourceAttr("data.powerflex_firmware_repository_compare.test", "component_diffs.#", "2"),
+					resource.TestCheckResourceAttr("data.powerflex_firmware_repository_compare.test", "component_diffs.0.node", "ABCD124"),
+					// the components of the report are not known in the repository of the resource group
+					resource.TestCheckResourceAttr("data.powerflex_firmware_repository_compare.test", "component_diffs.0.change", "missing"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if deploymentMocker != nil {
+				deploymentMocker.UnPatch()
+			}
+			if reportMocker != nil {
+				reportMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestFirmwareRepositoryCompare(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+
+	// the versions are compared numerically part by part
+	for _, tc := range []struct {
+		source, target, change string
+	}{
+		{"1.9.2", "1.10.2", helper.FirmwareChangeUpgrade},
+		{"7.00.00.171", "6.10.30.00", helper.FirmwareChangeDowngrade},
+		{"52.16.1-4405", "52.16.1-4405", helper.FirmwareChangeUnchanged},
+		{"A04", "A05", helper.FirmwareChangeUpgrade},
+		{"1.2", "1.2.1", helper.FirmwareChangeUpgrade},
+		{"1.2", "", helper.FirmwareChangeMissing},
+		{"", "1.2", helper.FirmwareChangeNew},
+	} {
+		if change := helper.GetFirmwareVersionChange(tc.source, tc.target); change != tc.change {
+			t.Fatalf("expected %s from %s to %s, got %s", tc.change, tc.source, tc.target, change)
+		}
+	}
+
+	// the components of two repositories are compared by component and the unchanged components are dropped
+	diffs := helper.CompareFirmwareRepositories(firmwareRepositoryCompareSourceMock, firmwareRepositoryCompareTargetMock, false)
+	if len(diffs) != 4 {
+		t.Fatalf("expected 4 differences, got %v", diffs)
+	}
+	expected := map[string]string{"BIOS": "upgrade", "iDRAC": "downgrade", "Mellanox NIC": "new", "PERC H755": "missing"}
+	for _, diff := range diffs {
+		if expected[diff.Name.ValueString()] != diff.Change.ValueString() {
+			t.Fatalf("unexpected change %s of %s", diff.Change.ValueString(), diff.Name.ValueString())
+		}
+	}
+	upgrades, downgrades, missing := helper.GetFirmwareDiffCounts(diffs)
+	if upgrades != 1 || downgrades != 1 || missing != 1 {
+		t.Fatalf("unexpected counts %d, %d, %d", upgrades, downgrades, missing)
+	}
+
+	// the unchanged components are listed on request
+	if diffs := helper.CompareFirmwareRepositories(firmwareRepositoryCompareSourceMock, firmwareRepositoryCompareSourceMock, true); len(diffs) != 3 {
+		t.Fatalf("expected 3 unchanged components, got %v", diffs)
+	}
+
+	// the components installed on the nodes are resolved by ID in the repository of the resource group
+	// and matched by component, the names of the components are not compared
+	reports := []scaleiotypes.ComplianceReport{
+		{
+			ServiceTag: "ABCD123",
+			DeviceType: "RackServer",
+			ComplianceReportComponents: []scaleiotypes.ComplianceReportComponents{
+				{
+					Name:           "System BIOS",
+					CurrentVersion: scaleiotypes.ComplianceReportComponentVersionInfo{FirmwareVersion: "1.9.2"},
+					TargetVersion:  scaleiotypes.ComplianceReportComponentVersionInfo{ID: "bios-1", FirmwareVersion: "1.9.2"},
+				},
+				{
+					Name:           "PERC H755",
+					CurrentVersion: scaleiotypes.ComplianceReportComponentVersionInfo{ID: "perc-1", FirmwareVersion: "52.16.1-4405"},
+				},
+				{Name: "iDRAC", CurrentVersion: scaleiotypes.ComplianceReportComponentVersionInfo{FirmwareVersion: "7.00.00.171"}},
+			},
+		},
+	}
+	diffs, err := helper.CompareResourceGroupFirmware(reports, firmwareRepositoryCompareSourceMock, firmwareRepositoryCompareTargetMock, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diffs) != 3 || diffs[0].Change.ValueString() != "missing" || diffs[0].Node.ValueString() != "ABCD123" ||
+		diffs[1].Name.ValueString() != "System BIOS" || diffs[1].Change.ValueString() != "upgrade" || diffs[1].TargetVersion.ValueString() != "1.10.2" ||
+		diffs[2].Name.ValueString() != "iDRAC" || diffs[2].Change.ValueString() != "missing" {
+		t.Fatalf("unexpected differences %v", diffs)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &licenseDataSource{}
+	_ datasource.DataSourceWithConfigure = &licenseDataSource{}
+)
+
+// LicenseDataSource returns the license data source
+func LicenseDataSource() datasource.DataSource {
+	return &licenseDataSource{}
+}
+
+type licenseDataSource struct {
+	client *goscaleio.Client
+	system *goscaleio.System
+}
+
+func (d *licenseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+func (d *licenseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = LicenseDataSourceSchema
+}
+
+func (d *licenseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	d.client = req.ProviderData.(*powerflexProvider).client
+	system, err := helper.GetFirstSystem(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Powerflex System",
+			err.Error(),
+		)
+		return
+	}
+	d.system = system
+}
+
+func (d *licenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	system, err := helper.GetLicenseDetails(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting license details",
+			err.Error(),
+		)
+		return
+	}
+
+	usedCapacityInKb, err := helper.GetUsedCapacityInKb(d.system)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system statistics",
+			err.Error(),
+		)
+		return
+	}
+
+	state := helper.MapLicenseDataSourceState(system, usedCapacityInKb)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
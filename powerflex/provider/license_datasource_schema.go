@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// LicenseDataSourceSchema defines the schema for license datasource
+var LicenseDataSourceSchema schema.Schema = schema.Schema{
+	Description:         "This datasource is used to read the license details of the PowerFlex array, such as the licensed capacity, the used capacity, the expiry and the enabled features.",
+	MarkdownDescription: "This datasource is used to read the license details of the PowerFlex array, such as the licensed capacity, the used capacity, the expiry and the enabled features.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the PowerFlex system.",
+			MarkdownDescription: "ID of the PowerFlex system.",
+			Computed:            true,
+		},
+		"installation_id": schema.StringAttribute{
+			Description:         "Installation ID of the PowerFlex system.",
+			MarkdownDescription: "Installation ID of the PowerFlex system.",
+			Computed:            true,
+		},
+		"swid": schema.StringAttribute{
+			Description:         "Software ID (SWID) of the installed license.",
+			MarkdownDescription: "Software ID (SWID) of the installed license.",
+			Computed:            true,
+		},
+		"max_capacity_in_gb": schema.StringAttribute{
+			Description:         "Maximum licensed capacity in GB.",
+			MarkdownDescription: "Maximum licensed capacity in GB.",
+			Computed:            true,
+		},
+		"used_capacity_in_gb": schema.Float64Attribute{
+			Description:         "Capacity in use on the PowerFlex system in GB.",
+			MarkdownDescription: "Capacity in use on the PowerFlex system in GB.",
+			Computed:            true,
+		},
+		"capacity_time_left_in_days": schema.StringAttribute{
+			Description:         "Number of days left before the license expires.",
+			MarkdownDescription: "Number of days left before the license expires.",
+			Computed:            true,
+		},
+		"days_installed": schema.Int64Attribute{
+			Description:         "Number of days since the PowerFlex system was installed.",
+			MarkdownDescription: "Number of days since the PowerFlex system was installed.",
+			Computed:            true,
+		},
+		"enterprise_features_enabled": schema.BoolAttribute{
+			Description:         "Specifies whether the enterprise features are enabled by the license.",
+			MarkdownDescription: "Specifies whether the enterprise features are enabled by the license.",
+			Computed:            true,
+		},
+		"is_initial_license": schema.BoolAttribute{
+			Description:         "Specifies whether the PowerFlex system is still running on the initial (trial) license.",
+			MarkdownDescription: "Specifies whether the PowerFlex system is still running on the initial (trial) license.",
+			Computed:            true,
+		},
+	},
+}
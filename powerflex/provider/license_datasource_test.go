@@ -0,0 +1,74 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Can be used for UT and AT
+func TestAccDatasourceLicense(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Successful read
+			{
+				Config: ProviderConfigForTesting + licenseData,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_license.default", "id"),
+					resource.TestCheckResourceAttrSet("data.powerflex_license.default", "max_capacity_in_gb"),
+					resource.TestCheckResourceAttrSet("data.powerflex_license.default", "used_capacity_in_gb"),
+				),
+			},
+			// Error reading the license details
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetLicenseDetails).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + licenseData,
+				ExpectError: regexp.MustCompile(`.*Error in getting license details*.`),
+			},
+			// Error reading the system statistics
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetUsedCapacityInKb).Return(0, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + licenseData,
+				ExpectError: regexp.MustCompile(`.*Error in getting system statistics*.`),
+			},
+		},
+	})
+}
+
+var licenseData = `
+data "powerflex_license" "default" {
+}
+`
@@ -0,0 +1,252 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &licenseResource{}
+	_ resource.ResourceWithConfigure   = &licenseResource{}
+	_ resource.ResourceWithImportState = &licenseResource{}
+	_ resource.ResourceWithModifyPlan  = &licenseResource{}
+)
+
+// NewLicenseResource - function to return resource interface
+func NewLicenseResource() resource.Resource {
+	return &licenseResource{}
+}
+
+// licenseResource - struct to define license resource
+type licenseResource struct {
+	client *goscaleio.Client
+	system *goscaleio.System
+}
+
+// Metadata - function to return metadata for license resource.
+func (r *licenseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+// Schema - function to return Schema for license resource.
+func (r *licenseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = LicenseResourceSchema
+}
+
+// Configure - function to return Configuration for license resource.
+func (r *licenseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	system, err := helper.GetFirstSystem(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Powerflex System",
+			err.Error(),
+		)
+		return
+	}
+	r.system = system
+}
+
+// ModifyPlan - function to plan the installation of the license when the content of the license file changes.
+func (r *licenseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction. Dont do anything.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan models.LicenseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the license file path may be known only during apply
+	if plan.LicenseFilePath.IsUnknown() {
+		return
+	}
+
+	hash, err := helper.GetLicenseFileHash(plan.LicenseFilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("license_file_path"),
+			"Error reading license file",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("license_file_hash"), hash)...)
+}
+
+// Create - function to install the license on the PowerFlex system.
+func (r *licenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.LicenseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := helper.SetLicense(r.client, r.system.System.ID, plan.LicenseFilePath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error installing license",
+			err.Error(),
+		)
+		return
+	}
+
+	system, err := helper.GetLicenseDetails(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting license details",
+			err.Error(),
+		)
+		return
+	}
+
+	// the license file path was only known during apply
+	if plan.LicenseFileHash.IsUnknown() {
+		hash, err := helper.GetLicenseFileHash(plan.LicenseFilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading license file",
+				err.Error(),
+			)
+			return
+		}
+		plan.LicenseFileHash = types.StringValue(hash)
+	}
+
+	state := helper.MapLicenseResourceState(system, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the license details of the PowerFlex system.
+func (r *licenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.LicenseResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	system, err := helper.GetLicenseDetails(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting license details",
+			err.Error(),
+		)
+		return
+	}
+
+	state = helper.MapLicenseResourceState(system, state)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to install a new license file on the PowerFlex system.
+func (r *licenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan, state models.LicenseResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.LicenseFilePath.Equal(state.LicenseFilePath) || !plan.LicenseFileHash.Equal(state.LicenseFileHash) {
+		err := helper.SetLicense(r.client, r.system.System.ID, plan.LicenseFilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error installing license",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	system, err := helper.GetLicenseDetails(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting license details",
+			err.Error(),
+		)
+		return
+	}
+
+	if plan.LicenseFileHash.IsUnknown() {
+		hash, err := helper.GetLicenseFileHash(plan.LicenseFilePath.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading license file",
+				err.Error(),
+			)
+			return
+		}
+		plan.LicenseFileHash = types.StringValue(hash)
+	}
+
+	state = helper.MapLicenseResourceState(system, plan)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to remove the license resource from the state.
+// An installed license cannot be removed from the PowerFlex system.
+func (r *licenseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState - function to import the license details of the PowerFlex system.
+func (r *licenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != "" && req.ID != r.system.System.ID {
+		resp.Diagnostics.AddError(
+			"Error in importing license",
+			"Could not import license of system with ID: "+req.ID,
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.system.System.ID)...)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// LicenseResourceSchema defines the schema for license resource
+var LicenseResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource is used to install a license on the PowerFlex array. We can Create and Update the license using this resource. We can also import the existing license details from the PowerFlex array.",
+	MarkdownDescription: "This resource is used to install a license on the PowerFlex array. We can Create and Update the license using this resource. We can also import the existing license details from the PowerFlex array.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the PowerFlex system on which the license is installed.",
+			MarkdownDescription: "ID of the PowerFlex system on which the license is installed.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"license_file_path": schema.StringAttribute{
+			Description:         "Path on your local machine to the license file ie: /example/license.lic. Updating this value, or the content of the license file, installs the new license file.",
+			MarkdownDescription: "Path on your local machine to the license file ie: /example/license.lic. Updating this value, or the content of the license file, installs the new license file.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"license_file_hash": schema.StringAttribute{
+			Description:         "SHA-256 hash of the content of the license file. The license is installed again when the content of the license file changes.",
+			MarkdownDescription: "SHA-256 hash of the content of the license file. The license is installed again when the content of the license file changes.",
+			Computed:            true,
+		},
+		"installation_id": schema.StringAttribute{
+			Description:         "Installation ID of the PowerFlex system.",
+			MarkdownDescription: "Installation ID of the PowerFlex system.",
+			Computed:            true,
+		},
+		"swid": schema.StringAttribute{
+			Description:         "Software ID (SWID) of the installed license.",
+			MarkdownDescription: "Software ID (SWID) of the installed license.",
+			Computed:            true,
+		},
+		"max_capacity_in_gb": schema.StringAttribute{
+			Description:         "Maximum licensed capacity in GB.",
+			MarkdownDescription: "Maximum licensed capacity in GB.",
+			Computed:            true,
+		},
+		"capacity_time_left_in_days": schema.StringAttribute{
+			Description:         "Number of days left before the license expires.",
+			MarkdownDescription: "Number of days left before the license expires.",
+			Computed:            true,
+		},
+		"days_installed": schema.Int64Attribute{
+			Description:         "Number of days since the PowerFlex system was installed.",
+			MarkdownDescription: "Number of days since the PowerFlex system was installed.",
+			Computed:            true,
+		},
+		"enterprise_features_enabled": schema.BoolAttribute{
+			Description:         "Specifies whether the enterprise features are enabled by the license.",
+			MarkdownDescription: "Specifies whether the enterprise features are enabled by the license.",
+			Computed:            true,
+		},
+		"is_initial_license": schema.BoolAttribute{
+			Description:         "Specifies whether the PowerFlex system is still running on the initial (trial) license.",
+			MarkdownDescription: "Specifies whether the PowerFlex system is still running on the initial (trial) license.",
+			Computed:            true,
+		},
+	},
+}
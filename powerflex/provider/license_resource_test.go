@@ -0,0 +1,215 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var licenseResourceConfig = `
+resource "powerflex_license" "test" {
+	license_file_path = "` + LicenseFilePath + `"
+}
+`
+
+var licenseResourceInvalidPath = `
+resource "powerflex_license" "test" {
+	license_file_path = "../fake/path/fake.lic"
+}
+`
+
+var licenseResourceEmptyPath = `
+resource "powerflex_license" "test" {
+	license_file_path = ""
+}
+`
+
+// AT
+func TestAccResourceLicense(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Install license
+			{
+				Config: ProviderConfigForTesting + licenseResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_license.test", "license_file_path", LicenseFilePath),
+					resource.TestCheckResourceAttr("powerflex_license.test", "is_initial_license", "false"),
+				),
+			},
+			// Import
+			{
+				ResourceName:            "powerflex_license.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"license_file_path"},
+			},
+		},
+	})
+}
+
+// UT
+func TestAccResourceLicenseUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var getLicenseMocker *Mocker
+	var installed int
+	var firstHash string
+	licenseFile := filepath.Join(t.TempDir(), "license.lic")
+	if err := os.WriteFile(licenseFile, []byte("LICENSE-1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	licenseFileConfig := `
+	resource "powerflex_license" "test" {
+		license_file_path = "` + filepath.ToSlash(licenseFile) + `"
+	}
+	`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Empty path
+			{
+				Config:      ProviderConfigForTesting + licenseResourceEmptyPath,
+				ExpectError: regexp.MustCompile(`.*string length must be at least 1*.`),
+			},
+			// Invalid path
+			{
+				Config:      ProviderConfigForTesting + licenseResourceInvalidPath,
+				ExpectError: regexp.MustCompile(`.*Could not read license file, make sure path to license file is correct*.`),
+			},
+			// Error installing the license
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetLicense).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + licenseFileConfig,
+				ExpectError: regexp.MustCompile(`.*Error installing license*.`),
+			},
+			// Error reading the license details
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetLicense).Return(nil).Build()
+					getLicenseMocker = Mock(helper.GetLicenseDetails).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + licenseFileConfig,
+				ExpectError: regexp.MustCompile(`.*Error in getting license details*.`),
+			},
+			// Install license successfully
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					if getLicenseMocker != nil {
+						getLicenseMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetLicense).To(func(_ *goscaleio.Client, _ string, _ string) error {
+						installed++
+						return nil
+					}).Build()
+				},
+				Config: ProviderConfigForTesting + licenseFileConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_license.test", "license_file_path", filepath.ToSlash(licenseFile)),
+					resource.TestCheckResourceAttrSet("powerflex_license.test", "installation_id"),
+					resource.TestCheckResourceAttrWith("powerflex_license.test", "license_file_hash", func(value string) error {
+						firstHash = value
+						return nil
+					}),
+				),
+			},
+			// New content written to the same path installs the license again
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(licenseFile, []byte("LICENSE-2\n"), 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: ProviderConfigForTesting + licenseFileConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("powerflex_license.test", "license_file_hash", func(value string) error {
+						if value == firstHash {
+							return fmt.Errorf("expected the hash of the new license file, got %s", value)
+						}
+						if installed != 2 {
+							return fmt.Errorf("expected the license to be installed again, installed %d times", installed)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestLicenseFileHash(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	licenseFile := filepath.Join(t.TempDir(), "license.lic")
+	hashOf := func(content string) string {
+		if err := os.WriteFile(licenseFile, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		hash, err := helper.GetLicenseFileHash(licenseFile)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return hash
+	}
+
+	// surrounding whitespace is not part of the license key
+	if hashOf("LICENSE-1") != hashOf("LICENSE-1\n") {
+		t.Fatal("expected the same hash for the same license key")
+	}
+	if hashOf("LICENSE-1") == hashOf("LICENSE-2") {
+		t.Fatal("expected a new hash for new license content")
+	}
+	if _, err := helper.GetLicenseFileHash(filepath.Join(t.TempDir(), "missing.lic")); err == nil {
+		t.Fatal("expected error for a missing license file")
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &networkDataSource{}
+	_ datasource.DataSourceWithConfigure = &networkDataSource{}
+)
+
+// NetworkDataSource returns the network data source
+func NetworkDataSource() datasource.DataSource {
+	return &networkDataSource{}
+}
+
+type networkDataSource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+func (d *networkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+func (d *networkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = NetworkDataSourceSchema
+}
+
+func (d *networkDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	d.client = req.ProviderData.(*powerflexProvider).client
+	d.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+func (d *networkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Started network data source read method")
+	var state models.NetworkDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networks, err := helper.GetAllNetworks(d.gatewayClient, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error in getting network details", err.Error())
+		return
+	}
+
+	if state.NetworkFilter != nil {
+		filtered, err := helper.GetDataSourceByValue(*state.NetworkFilter, networks)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error in filtering networks: %v please validate the filter", state.NetworkFilter), err.Error(),
+			)
+			return
+		}
+		filteredNetworks := []scaleiotypes.Networks{}
+		for _, val := range filtered {
+			filteredNetworks = append(filteredNetworks, val.(scaleiotypes.Networks))
+		}
+		networks = filteredNetworks
+	}
+
+	state.NetworkDetails = make([]models.NetworkModel, 0, len(networks))
+	for _, network := range networks {
+		state.NetworkDetails = append(state.NetworkDetails, helper.GetNetworkModel(network))
+	}
+	state.ID = types.StringValue("network_datasource_id")
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// NetworkDataSourceSchema defines the schema for network datasource
+var NetworkDataSourceSchema schema.Schema = schema.Schema{
+	Description:         "This datasource is used to query the existing networks of PowerFlex Manager. The information fetched from this datasource can be used for getting the details / for further processing in resource block.",
+	MarkdownDescription: "This datasource is used to query the existing networks of PowerFlex Manager. The information fetched from this datasource can be used for getting the details / for further processing in resource block.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "default datasource id",
+			MarkdownDescription: "default datasource id",
+			Computed:            true,
+		},
+		"network_details": schema.ListNestedAttribute{
+			Description:         "List of networks",
+			MarkdownDescription: "List of networks",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: networkDataAttributes,
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"filter": schema.SingleNestedBlock{
+			Attributes: helper.GenerateSchemaAttributes(helper.TypeToMap(models.NetworkFilter{})),
+		},
+	},
+}
+
+var networkDataAttributes map[string]schema.Attribute = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description:         "ID of the network.",
+		MarkdownDescription: "ID of the network.",
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		Description:         "Name of the network.",
+		MarkdownDescription: "Name of the network.",
+		Computed:            true,
+	},
+	"description": schema.StringAttribute{
+		Description:         "Description of the network.",
+		MarkdownDescription: "Description of the network.",
+		Computed:            true,
+	},
+	"type": schema.StringAttribute{
+		Description:         "Type of the network.",
+		MarkdownDescription: "Type of the network.",
+		Computed:            true,
+	},
+	"vlan_id": schema.Int64Attribute{
+		Description:         "VLAN ID of the network.",
+		MarkdownDescription: "VLAN ID of the network.",
+		Computed:            true,
+	},
+	"static": schema.BoolAttribute{
+		Description:         "Whether the network is statically configured.",
+		MarkdownDescription: "Whether the network is statically configured.",
+		Computed:            true,
+	},
+	"subnet": schema.StringAttribute{
+		Description:         "Subnet mask of the network.",
+		MarkdownDescription: "Subnet mask of the network.",
+		Computed:            true,
+	},
+	"gateway": schema.StringAttribute{
+		Description:         "Gateway of the network.",
+		MarkdownDescription: "Gateway of the network.",
+		Computed:            true,
+	},
+	"primary_dns": schema.StringAttribute{
+		Description:         "Primary DNS server of the network.",
+		MarkdownDescription: "Primary DNS server of the network.",
+		Computed:            true,
+	},
+	"secondary_dns": schema.StringAttribute{
+		Description:         "Secondary DNS server of the network.",
+		MarkdownDescription: "Secondary DNS server of the network.",
+		Computed:            true,
+	},
+	"dns_suffix": schema.StringAttribute{
+		Description:         "DNS suffix of the network.",
+		MarkdownDescription: "DNS suffix of the network.",
+		Computed:            true,
+	},
+	"ip_ranges": schema.ListNestedAttribute{
+		Description:         "IP ranges of the network.",
+		MarkdownDescription: "IP ranges of the network.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description:         "ID of the IP range.",
+					MarkdownDescription: "ID of the IP range.",
+					Computed:            true,
+				},
+				"start_ip": schema.StringAttribute{
+					Description:         "Starting IP of the range.",
+					MarkdownDescription: "Starting IP of the range.",
+					Computed:            true,
+				},
+				"end_ip": schema.StringAttribute{
+					Description:         "Ending IP of the range.",
+					MarkdownDescription: "Ending IP of the range.",
+					Computed:            true,
+				},
+				"role": schema.StringAttribute{
+					Description:         "Role of the IP range.",
+					MarkdownDescription: "Role of the IP range.",
+					Computed:            true,
+				},
+			},
+		},
+	},
+	"static_routes": schema.ListNestedAttribute{
+		Description:         "Static routes of the network.",
+		MarkdownDescription: "Static routes of the network.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"source_network_id": schema.StringAttribute{
+					Description:         "ID of the source network of the route.",
+					MarkdownDescription: "ID of the source network of the route.",
+					Computed:            true,
+				},
+				"destination_network_id": schema.StringAttribute{
+					Description:         "ID of the destination network of the route.",
+					MarkdownDescription: "ID of the destination network of the route.",
+					Computed:            true,
+				},
+				"gateway": schema.StringAttribute{
+					Description:         "Gateway of the route.",
+					MarkdownDescription: "Gateway of the route.",
+					Computed:            true,
+				},
+				"subnet_mask": schema.StringAttribute{
+					Description:         "Subnet mask of the destination of the route.",
+					MarkdownDescription: "Subnet mask of the destination of the route.",
+					Computed:            true,
+				},
+				"destination_ip_address": schema.StringAttribute{
+					Description:         "IP address of the destination of the route.",
+					MarkdownDescription: "IP address of the destination of the route.",
+					Computed:            true,
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var NetworkDataSourceConfig1 = `
+data "powerflex_network" "example" {
+}
+`
+
+var NetworkDataSourceConfig2 = `
+data "powerflex_network" "example" {
+	filter{
+		name = ["pflex-data1"]
+		vlan_id = [161]
+	}
+}
+`
+
+var networkDataSourceMock = []scaleiotypes.Networks{
+	*networkResourceMock,
+	{
+		ID:     "8aaa80658cd602e0018cd996a1c91bdf",
+		Name:   "pflex-pxe",
+		Type:   "PXE",
+		VlanID: 150,
+	},
+}
+
+func TestAccDatasourceAcceptanceNetwork(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + NetworkDataSourceConfig1,
+				Check:  resource.ComposeAggregateTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func TestAccDatasourceNetwork(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var filterMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetAllNetworks).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + NetworkDataSourceConfig1,
+				ExpectError: regexp.MustCompile(`.*Error in getting network details*.`),
+			},
+			// Read all the networks
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetAllNetworks).Return(networkDataSourceMock, nil).Build()
+				},
+				Config: ProviderConfigForTesting + NetworkDataSourceConfig1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_network.example", "network_details.#", "2"),
+				),
+			},
+			// Filter the networks
+			{
+				Config: ProviderConfigForTesting + NetworkDataSourceConfig2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_network.example", "network_details.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_network.example", "network_details.0.name", "pflex-data1"),
+					resource.TestCheckResourceAttr("data.powerflex_network.example", "network_details.0.ip_ranges.0.start_ip", "10.10.161.20"),
+				),
+			},
+			// Filter error
+			{
+				PreConfig: func() {
+					filterMocker = Mock(helper.GetDataSourceByValue).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + NetworkDataSourceConfig2,
+				ExpectError: regexp.MustCompile(`.*Error in filtering networks*.`),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if filterMocker != nil {
+				filterMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &networkResource{}
+	_ resource.ResourceWithConfigure   = &networkResource{}
+	_ resource.ResourceWithImportState = &networkResource{}
+)
+
+// NewNetworkResource - function to return resource interface
+func NewNetworkResource() resource.Resource {
+	return &networkResource{}
+}
+
+// networkResource - struct to define network resource
+type networkResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for network resource.
+func (r *networkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+// Schema - function to return Schema for network resource.
+func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = NetworkResourceSchema
+}
+
+// Configure - function to return Configuration for network resource.
+func (r *networkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// Create - function to create the network.
+func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.NetworkResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := helper.GetNetworkPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateNetwork(r.gatewayClient, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating network",
+			err.Error(),
+		)
+		return
+	}
+
+	state, diags := r.read(ctx, id, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the network.
+func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.NetworkResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = r.read(ctx, state.ID.ValueString(), state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to update the network.
+func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan, state models.NetworkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	payload, diags := helper.GetNetworkPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := helper.UpdateNetwork(r.gatewayClient, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating network",
+			err.Error(),
+		)
+		return
+	}
+
+	state, diags = r.read(ctx, state.ID.ValueString(), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to delete the network.
+func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	var state models.NetworkResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := helper.DeleteNetwork(r.gatewayClient, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting network",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState - function to import the network.
+func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read gets the network and sets it in the state
+func (r *networkResource) read(ctx context.Context, id string, plan models.NetworkResourceModel) (models.NetworkResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	network, err := helper.GetNetworkByID(r.gatewayClient, r.client, id)
+	if err != nil {
+		diags.AddError(
+			"Error in getting network details",
+			err.Error(),
+		)
+		return plan, diags
+	}
+	return helper.UpdateNetworkState(ctx, plan, network)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// NetworkResourceSchema defines the schema for network resource
+var NetworkResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to manage the networks of PowerFlex Manager, with their VLAN ID, subnet, gateway, DNS, IP ranges and static routes." +
+		" The networks can be referenced by the templates which are deployed as resource groups." +
+		" This resource supports Create, Update, Delete and Import operations.",
+	MarkdownDescription: "This resource is used to manage the networks of PowerFlex Manager, with their VLAN ID, subnet, gateway, DNS, IP ranges and static routes." +
+		" The networks can be referenced by the templates which are deployed as resource groups." +
+		" This resource supports Create, Update, Delete and Import operations.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the network.",
+			MarkdownDescription: "ID of the network.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the network.",
+			MarkdownDescription: "Name of the network.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the network.",
+			MarkdownDescription: "Description of the network.",
+			Optional:            true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of the network, for example SCALEIO_DATA, SCALEIO_MANAGEMENT, HYPERVISOR_MANAGEMENT, PXE or GENERAL." +
+				" Cannot be updated.",
+			MarkdownDescription: "Type of the network, for example `SCALEIO_DATA`, `SCALEIO_MANAGEMENT`, `HYPERVISOR_MANAGEMENT`, `PXE` or `GENERAL`." +
+				" Cannot be updated.",
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"vlan_id": schema.Int64Attribute{
+			Description:         "VLAN ID of the network.",
+			MarkdownDescription: "VLAN ID of the network.",
+			Required:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+		},
+		"static": schema.BoolAttribute{
+			Description:         "Whether the network is statically configured. The network is static when subnet is specified, otherwise the addresses are assigned by DHCP.",
+			MarkdownDescription: "Whether the network is statically configured. The network is static when `subnet` is specified, otherwise the addresses are assigned by DHCP.",
+			Computed:            true,
+		},
+		"subnet": schema.StringAttribute{
+			Description:         "Subnet mask of the network, for example 255.255.255.0.",
+			MarkdownDescription: "Subnet mask of the network, for example `255.255.255.0`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"gateway": schema.StringAttribute{
+			Description:         "Gateway of the network.",
+			MarkdownDescription: "Gateway of the network.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"primary_dns": schema.StringAttribute{
+			Description:         "Primary DNS server of the network.",
+			MarkdownDescription: "Primary DNS server of the network.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"secondary_dns": schema.StringAttribute{
+			Description:         "Secondary DNS server of the network.",
+			MarkdownDescription: "Secondary DNS server of the network.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"dns_suffix": schema.StringAttribute{
+			Description:         "DNS suffix of the network.",
+			MarkdownDescription: "DNS suffix of the network.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"ip_ranges": schema.ListNestedAttribute{
+			Description:         "IP ranges of the network from which the addresses are assigned. Requires subnet.",
+			MarkdownDescription: "IP ranges of the network from which the addresses are assigned. Requires `subnet`.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "ID of the IP range.",
+						MarkdownDescription: "ID of the IP range.",
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"start_ip": schema.StringAttribute{
+						Description:         "Starting IP of the range.",
+						MarkdownDescription: "Starting IP of the range.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"end_ip": schema.StringAttribute{
+						Description:         "Ending IP of the range.",
+						MarkdownDescription: "Ending IP of the range.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"role": schema.StringAttribute{
+						Description:         "Role of the IP range, applicable to the PowerFlex data networks.",
+						MarkdownDescription: "Role of the IP range, applicable to the PowerFlex data networks.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"static_routes": schema.ListNestedAttribute{
+			Description:         "Static routes of the network. Requires subnet.",
+			MarkdownDescription: "Static routes of the network. Requires `subnet`.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"source_network_id": schema.StringAttribute{
+						Description:         "ID of the source network of the route.",
+						MarkdownDescription: "ID of the source network of the route.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"destination_network_id": schema.StringAttribute{
+						Description:         "ID of the destination network of the route.",
+						MarkdownDescription: "ID of the destination network of the route.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"gateway": schema.StringAttribute{
+						Description:         "Gateway of the route.",
+						MarkdownDescription: "Gateway of the route.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"subnet_mask": schema.StringAttribute{
+						Description:         "Subnet mask of the destination of the route.",
+						MarkdownDescription: "Subnet mask of the destination of the route.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"destination_ip_address": schema.StringAttribute{
+						Description:         "IP address of the destination of the route.",
+						MarkdownDescription: "IP address of the destination of the route.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,198 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var networkResourceConfig = `
+resource "powerflex_network" "test" {
+	name = "pflex-data1"
+	type = "SCALEIO_DATA"
+	vlan_id = 161
+	subnet = "255.255.255.0"
+	gateway = "10.10.161.1"
+	primary_dns = "10.10.10.10"
+	ip_ranges = [
+		{
+			start_ip = "10.10.161.20"
+			end_ip = "10.10.161.40"
+		}
+	]
+}
+`
+
+var networkResourceNoSubnetConfig = `
+resource "powerflex_network" "test" {
+	name = "pflex-data1"
+	type = "SCALEIO_DATA"
+	vlan_id = 161
+	gateway = "10.10.161.1"
+}
+`
+
+var networkResourceMock = &scaleiotypes.Networks{
+	ID:     "8aaa80658cd602e0018cd996a1c91bdd",
+	Name:   "pflex-data1",
+	Type:   "SCALEIO_DATA",
+	VlanID: 161,
+	Static: true,
+	StaticNetworkConfiguration: scaleiotypes.StaticNetworkConfiguration{
+		Subnet:     "255.255.255.0",
+		Gateway:    "10.10.161.1",
+		PrimaryDNS: "10.10.10.10",
+		IPRange: []scaleiotypes.IPRange{
+			{ID: "8aaa80658cd602e0018cd996a1c91bde", StartingIP: "10.10.161.20", EndingIP: "10.10.161.40"},
+		},
+	},
+}
+
+// UT
+func TestAccResourceNetworkUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var getMocker, deleteMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Gateway requires subnet
+			{
+				Config:      ProviderConfigForTesting + networkResourceNoSubnetConfig,
+				ExpectError: regexp.MustCompile(`.*subnet is required*.`),
+			},
+			// Error creating the network
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateNetwork).Return("", fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + networkResourceConfig,
+				ExpectError: regexp.MustCompile(`.*Error creating network*.`),
+			},
+			// Create the network
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateNetwork).Return(networkResourceMock.ID, nil).Build()
+					getMocker = Mock(helper.GetNetworkByID).Return(networkResourceMock, nil).Build()
+					deleteMocker = Mock(helper.DeleteNetwork).Return(nil).Build()
+				},
+				Config: ProviderConfigForTesting + networkResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_network.test", "id", networkResourceMock.ID),
+					resource.TestCheckResourceAttr("powerflex_network.test", "static", "true"),
+					resource.TestCheckResourceAttr("powerflex_network.test", "ip_ranges.0.id", "8aaa80658cd602e0018cd996a1c91bde"),
+					resource.TestCheckNoResourceAttr("powerflex_network.test", "secondary_dns"),
+					resource.TestCheckNoResourceAttr("powerflex_network.test", "ip_ranges.0.role"),
+				),
+			},
+			// Import the network
+			{
+				ResourceName:      "powerflex_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if getMocker != nil {
+				getMocker.UnPatch()
+			}
+			if deleteMocker != nil {
+				deleteMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestNetworkPayload(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	ipRangeType := types.ObjectType{AttrTypes: helper.NetworkIPRangeAttrTypes}
+	plan := models.NetworkResourceModel{
+		ID:           types.StringUnknown(),
+		Name:         types.StringValue("pflex-data1"),
+		Type:         types.StringValue("SCALEIO_DATA"),
+		VlanID:       types.Int64Value(161),
+		Subnet:       types.StringValue("255.255.255.0"),
+		Gateway:      types.StringValue("10.10.161.1"),
+		StaticRoutes: types.ListNull(types.ObjectType{AttrTypes: helper.NetworkStaticRouteAttrTypes}),
+		IPRanges: types.ListValueMust(ipRangeType, []attr.Value{
+			types.ObjectValueMust(helper.NetworkIPRangeAttrTypes, map[string]attr.Value{
+				"id":       types.StringUnknown(),
+				"start_ip": types.StringValue("10.10.161.20"),
+				"end_ip":   types.StringValue("10.10.161.40"),
+				"role":     types.StringNull(),
+			}),
+		}),
+	}
+
+	// the network is static when the subnet is set
+	network, diags := helper.GetNetworkPayload(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !network.Static || network.ID != "" || network.VlanID != 161 {
+		t.Fatalf("unexpected network: %+v", network)
+	}
+	if len(network.StaticNetworkConfiguration.IPRange) != 1 || network.StaticNetworkConfiguration.IPRange[0].ID != "" {
+		t.Fatalf("expected one new IP range, got %+v", network.StaticNetworkConfiguration.IPRange)
+	}
+
+	// the IP ranges require the subnet
+	plan.Subnet = types.StringNull()
+	plan.Gateway = types.StringNull()
+	if _, diags := helper.GetNetworkPayload(context.Background(), plan); !diags.HasError() {
+		t.Fatal("expected error when IP ranges are set without subnet")
+	}
+
+	// the network without subnet is configured by DHCP
+	plan.IPRanges = types.ListNull(ipRangeType)
+	network, diags = helper.GetNetworkPayload(context.Background(), plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if network.Static {
+		t.Fatal("expected the network without subnet not to be static")
+	}
+}
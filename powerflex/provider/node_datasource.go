@@ -115,8 +115,19 @@ func (d *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		nodeDetails = nodeDetailFiltered
 	}
 
+	// the inventory date is not part of the node details
+	lastRefreshDates := map[string]string{}
+	inventories, err := helper.GetNodeInventories(d.gatewayClient, d.client)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read the inventory date of the nodes", err.Error(),
+		)
+	} else {
+		lastRefreshDates = helper.GetNodeLastRefreshDates(inventories)
+	}
+
 	for _, node := range nodeDetails {
-		nodeModel = append(nodeModel, helper.GetNodeState(node))
+		nodeModel = append(nodeModel, helper.GetNodeState(node, lastRefreshDates[node.RefID]))
 	}
 
 	state.NodeDetails = nodeModel
@@ -150,6 +150,11 @@ var NodeDataSourceSchema schema.Schema = schema.Schema{
 						MarkdownDescription: "Compliance check date.",
 						Computed:            true,
 					},
+					"last_refresh_date": schema.StringAttribute{
+						Description:         "Date of the last inventory refresh of the node, as reported by PowerFlex Manager. It is empty until the node is inventoried.",
+						MarkdownDescription: "Date of the last inventory refresh of the node, as reported by PowerFlex Manager. It is empty until the node is inventoried.",
+						Computed:            true,
+					},
 					"discovered_date": schema.StringAttribute{
 						Description:         "Discovered date of the node.",
 						MarkdownDescription: "Discovered date of the node.",
@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &nodeInventoryRefreshResource{}
+	_ resource.ResourceWithConfigure  = &nodeInventoryRefreshResource{}
+	_ resource.ResourceWithModifyPlan = &nodeInventoryRefreshResource{}
+)
+
+// NewNodeInventoryRefreshResource - function to return resource interface
+func NewNodeInventoryRefreshResource() resource.Resource {
+	return &nodeInventoryRefreshResource{}
+}
+
+// nodeInventoryRefreshResource - struct to define node inventory refresh resource
+type nodeInventoryRefreshResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for node inventory refresh resource.
+func (r *nodeInventoryRefreshResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_inventory_refresh"
+}
+
+// Schema - function to return Schema for node inventory refresh resource.
+func (r *nodeInventoryRefreshResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = NodeInventoryRefreshResourceSchema
+}
+
+// Configure - function to return Configuration for node inventory refresh resource.
+func (r *nodeInventoryRefreshResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// ModifyPlan - function to plan a refresh when the inventory of the nodes is older than max_age.
+func (r *nodeInventoryRefreshResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is getting created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.NodeInventoryRefreshResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !helper.Known(plan.MaxAge) || plan.MaxAge.ValueInt64() == 0 || state.NodeDetails.IsNull() {
+		return
+	}
+
+	var details []models.NodeInventoryModel
+	resp.Diagnostics.Append(state.NodeDetails.ElementsAs(ctx, &details, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since := time.Now().Add(-time.Duration(plan.MaxAge.ValueInt64()) * time.Minute)
+	for _, detail := range details {
+		if helper.IsNodeInventoryStale(detail.LastRefreshDate.ValueString(), since) {
+			tflog.Info(ctx, "Inventory of node is older than max_age", map[string]interface{}{"ref_id": detail.RefID.ValueString(), "last_refresh_date": detail.LastRefreshDate.ValueString()})
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("node_details"), types.ListUnknown(types.ObjectType{AttrTypes: helper.NodeInventoryAttrTypes}))...)
+			return
+		}
+	}
+}
+
+// Create - function to refresh the inventory of the nodes.
+func (r *nodeInventoryRefreshResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.NodeInventoryRefreshResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.refresh(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the inventory of the nodes.
+func (r *nodeInventoryRefreshResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.NodeInventoryRefreshResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = r.read(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to refresh the inventory of the nodes again.
+func (r *nodeInventoryRefreshResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan models.NodeInventoryRefreshResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.refresh(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to remove the inventory refresh from the state.
+func (r *nodeInventoryRefreshResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	resp.State.RemoveResource(ctx)
+}
+
+// refresh refreshes the inventory of the nodes and waits for the refresh to complete
+func (r *nodeInventoryRefreshResource) refresh(ctx context.Context, plan models.NodeInventoryRefreshResourceModel) (models.NodeInventoryRefreshResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	inventories, err := helper.GetNodeInventories(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError("Error in getting the inventory of the nodes", err.Error())
+		return plan, diags
+	}
+
+	var nodes []string
+	diags.Append(plan.Nodes.ElementsAs(ctx, &nodes, false)...)
+	if diags.HasError() {
+		return plan, diags
+	}
+	targets, err := helper.GetNodeInventoryTargets(inventories, nodes)
+	if err != nil {
+		diags.AddError("Error refreshing the inventory of the nodes", err.Error())
+		return plan, diags
+	}
+
+	refIDs := make([]string, 0, len(targets))
+	for _, target := range targets {
+		refIDs = append(refIDs, target.RefID)
+	}
+
+	since := time.Now()
+	tflog.Info(ctx, "Refreshing the inventory of the nodes", map[string]interface{}{"nodes": refIDs})
+	err = helper.StartNodeInventoryRefresh(r.gatewayClient, r.client, refIDs)
+	if err != nil {
+		diags.AddError("Error refreshing the inventory of the nodes", err.Error())
+		return plan, diags
+	}
+	err = helper.WaitForNodeInventoryRefresh(ctx, r.gatewayClient, r.client, refIDs, since, time.Duration(plan.Timeout.ValueInt64())*time.Minute)
+	if err != nil {
+		diags.AddError("Error refreshing the inventory of the nodes", err.Error())
+		return plan, diags
+	}
+	return r.read(ctx, plan)
+}
+
+// read gets the inventory of the nodes
+func (r *nodeInventoryRefreshResource) read(ctx context.Context, plan models.NodeInventoryRefreshResourceModel) (models.NodeInventoryRefreshResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	inventories, err := helper.GetNodeInventories(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError("Error in getting the inventory of the nodes", err.Error())
+		return plan, diags
+	}
+
+	var nodes []string
+	diags.Append(plan.Nodes.ElementsAs(ctx, &nodes, false)...)
+	if diags.HasError() {
+		return plan, diags
+	}
+	targets, err := helper.GetNodeInventoryTargets(inventories, nodes)
+	if err != nil {
+		diags.AddError("Error in getting the inventory of the nodes", err.Error())
+		return plan, diags
+	}
+	return helper.UpdateNodeInventoryRefreshState(ctx, plan, targets)
+}
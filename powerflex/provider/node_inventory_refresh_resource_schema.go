@@ -0,0 +1,119 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NodeInventoryRefreshResourceSchema defines the schema for node inventory refresh resource
+var NodeInventoryRefreshResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to refresh the inventory and health of nodes of PowerFlex Manager, so that the health and compliance of the nodes are based on current data." +
+		" The inventory is refreshed on create and update, and once it is older than `max_age` on the next apply." +
+		" This resource supports Create, Update and Delete operations. Delete only removes the resource from the state.",
+	MarkdownDescription: "This resource is used to refresh the inventory and health of nodes of PowerFlex Manager, so that the health and compliance of the nodes are based on current data." +
+		" The inventory is refreshed on create and update, and once it is older than `max_age` on the next apply." +
+		" This resource supports Create, Update and Delete operations. Delete only removes the resource from the state.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the inventory refresh, which is the comma separated list of the reference IDs of the nodes.",
+			MarkdownDescription: "ID of the inventory refresh, which is the comma separated list of the reference IDs of the nodes.",
+			Computed:            true,
+		},
+		"nodes": schema.SetAttribute{
+			Description:         "Reference IDs, service tags or IP addresses of the nodes whose inventory is refreshed.",
+			MarkdownDescription: "Reference IDs, service tags or IP addresses of the nodes whose inventory is refreshed.",
+			Required:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"max_age": schema.Int64Attribute{
+			Description:         "Maximum age in minutes of the inventory of the nodes. The inventory is refreshed again on the next apply once it is older. If set to 0, the inventory is only refreshed on create and update. Default value is `60`.",
+			MarkdownDescription: "Maximum age in minutes of the inventory of the nodes. The inventory is refreshed again on the next apply once it is older. If set to 0, the inventory is only refreshed on create and update. Default value is `60`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(60),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"timeout": schema.Int64Attribute{
+			Description:         "Describes the time in minutes to timeout the inventory refresh.",
+			MarkdownDescription: "Describes the time in minutes to timeout the inventory refresh.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(30),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"node_details": schema.ListNestedAttribute{
+			Description:         "Inventory and health of the nodes after the refresh.",
+			MarkdownDescription: "Inventory and health of the nodes after the refresh.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"ref_id": schema.StringAttribute{
+						Description:         "Reference ID of the node.",
+						MarkdownDescription: "Reference ID of the node.",
+						Computed:            true,
+					},
+					"service_tag": schema.StringAttribute{
+						Description:         "Service tag of the node.",
+						MarkdownDescription: "Service tag of the node.",
+						Computed:            true,
+					},
+					"ip_address": schema.StringAttribute{
+						Description:         "IP address of the node.",
+						MarkdownDescription: "IP address of the node.",
+						Computed:            true,
+					},
+					"state": schema.StringAttribute{
+						Description:         "State of the node.",
+						MarkdownDescription: "State of the node.",
+						Computed:            true,
+					},
+					"health": schema.StringAttribute{
+						Description:         "Health of the node.",
+						MarkdownDescription: "Health of the node.",
+						Computed:            true,
+					},
+					"health_message": schema.StringAttribute{
+						Description:         "Health message of the node.",
+						MarkdownDescription: "Health message of the node.",
+						Computed:            true,
+					},
+					"last_refresh_date": schema.StringAttribute{
+						Description:         "Date of the last inventory refresh of the node, as reported by PowerFlex Manager.",
+						MarkdownDescription: "Date of the last inventory refresh of the node, as reported by PowerFlex Manager.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,172 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+	"time"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var nodeInventoryRefreshConfig = `
+resource "powerflex_node_inventory_refresh" "test" {
+	nodes = ["ABCD123", "10.10.10.2"]
+}
+`
+
+func nodeInventoriesMock(inventoryDate string) []helper.NodeInventory {
+	return []helper.NodeInventory{
+		{
+			RefID:         "scaleio-block-legacy-gateway",
+			ServiceTag:    "ABCD123",
+			IPAddress:     "10.10.10.1",
+			State:         "READY",
+			Health:        "GREEN",
+			InventoryDate: inventoryDate,
+		},
+		{
+			RefID:         "scaleio-block-legacy-gateway-2",
+			ServiceTag:    "ABCD124",
+			IPAddress:     "10.10.10.2",
+			State:         "READY",
+			Health:        "YELLOW",
+			HealthMessage: "Power supply redundancy is lost",
+			InventoryDate: inventoryDate,
+		},
+	}
+}
+
+// UT
+func TestAccResourceNodeInventoryRefreshUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var refreshMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error getting the inventory of the nodes
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetNodeInventories).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + nodeInventoryRefreshConfig,
+				ExpectError: regexp.MustCompile(`.*Error in getting the inventory of the nodes*.`),
+			},
+			// Error refreshing the inventory of the nodes
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetNodeInventories).Return(nodeInventoriesMock(time.Now().UTC().Format(time.RFC3339Nano)), nil).Build()
+					refreshMocker = Mock(helper.StartNodeInventoryRefresh).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + nodeInventoryRefreshConfig,
+				ExpectError: regexp.MustCompile(`.*Error refreshing the inventory of the nodes*.`),
+			},
+			// The inventory of the nodes is refreshed
+			{
+				PreConfig: func() {
+					if refreshMocker != nil {
+						refreshMocker.UnPatch()
+					}
+					refreshMocker = Mock(helper.StartNodeInventoryRefresh).Return(nil).Build()
+				},
+				Config: ProviderConfigForTesting + nodeInventoryRefreshConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_node_inventory_refresh.test", "node_details.#", "2"),
+					resource.TestCheckResourceAttr("powerflex_node_inventory_refresh.test", "node_details.1.health", "YELLOW"),
+					resource.TestCheckResourceAttrSet("powerflex_node_inventory_refresh.test", "node_details.0.last_refresh_date"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if refreshMocker != nil {
+				refreshMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestNodeInventoryTargets(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	inventories := nodeInventoriesMock("")
+
+	// the nodes are selected by reference ID, service tag or IP address
+	targets, err := helper.GetNodeInventoryTargets(inventories, []string{"abcd123", "10.10.10.2", "scaleio-block-legacy-gateway"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 3 || targets[1].ServiceTag != "ABCD124" {
+		t.Fatalf("expected the nodes to be selected, got %v", targets)
+	}
+
+	// the nodes must be part of the inventory
+	if _, err := helper.GetNodeInventoryTargets(inventories, []string{"XYZ"}); err == nil {
+		t.Fatal("expected error for a node which is not part of the inventory")
+	}
+
+	// the refresh date must be returned by PowerFlex Manager
+	if _, err := helper.ParseNodeLastRefreshDate(inventories[0]); err == nil {
+		t.Fatal("expected error for a node without inventory date")
+	}
+	if _, err := helper.ParseNodeLastRefreshDate(nodeInventoriesMock("2024-05-08")[0]); err == nil {
+		t.Fatal("expected error for an invalid inventory date")
+	}
+	date, err := helper.ParseNodeLastRefreshDate(nodeInventoriesMock("2024-05-08T11:16:52.951+00:00")[0])
+	if err != nil || date.Minute() != 16 {
+		t.Fatalf("expected the inventory date as last refresh date, got %v, %v", date, err)
+	}
+
+	// the last refresh date of each node is its inventory date
+	dates := helper.GetNodeLastRefreshDates(nodeInventoriesMock("2024-05-08T11:16:52.951+00:00"))
+	if dates["scaleio-block-legacy-gateway-2"] != "2024-05-08T11:16:52.951+00:00" || dates["unknown"] != "" {
+		t.Fatalf("expected the inventory dates of the nodes, got %v", dates)
+	}
+
+	// the inventory is stale once it is older than the given time
+	since := time.Date(2024, 5, 8, 12, 0, 0, 0, time.UTC)
+	if !helper.IsNodeInventoryStale("2024-05-08T11:16:51.805+00:00", since) {
+		t.Fatal("expected the inventory to be stale")
+	}
+	if helper.IsNodeInventoryStale("2024-05-08T12:16:51.805+00:00", since) {
+		t.Fatal("expected the inventory to be current")
+	}
+	if !helper.IsNodeInventoryStale("", since) {
+		t.Fatal("expected an unknown refresh date to be stale")
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &ntpDNSSettingsResource{}
+	_ resource.ResourceWithConfigure   = &ntpDNSSettingsResource{}
+	_ resource.ResourceWithImportState = &ntpDNSSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &ntpDNSSettingsResource{}
+)
+
+// NewNtpDNSSettingsResource - function to return resource interface
+func NewNtpDNSSettingsResource() resource.Resource {
+	return &ntpDNSSettingsResource{}
+}
+
+// ntpDNSSettingsResource - struct to define NTP DNS settings resource
+type ntpDNSSettingsResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for NTP DNS settings resource.
+func (r *ntpDNSSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ntp_dns_settings"
+}
+
+// Schema - function to return Schema for NTP DNS settings resource.
+func (r *ntpDNSSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = NtpDNSSettingsResourceSchema
+}
+
+// Configure - function to return Configuration for NTP DNS settings resource.
+func (r *ntpDNSSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// ModifyPlan - function to plan an update when the settings of the cluster nodes have drifted.
+func (r *ntpDNSSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is getting created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state models.NtpDNSSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the nodes which are out of sync are expected to be in sync after the apply
+	if len(state.OutOfSyncNodes.Elements()) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("out_of_sync_nodes"), types.ListValueMust(types.StringType, nil))...)
+	}
+}
+
+// Create - function to set the NTP, DNS and timezone settings.
+func (r *ntpDNSSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.NtpDNSSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the NTP, DNS and timezone settings.
+func (r *ntpDNSSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.NtpDNSSettingsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = r.read(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to update the NTP, DNS and timezone settings.
+func (r *ntpDNSSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan models.NtpDNSSettingsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to remove the NTP, DNS and timezone settings from the state.
+// The settings are left as is on PowerFlex Manager and on the cluster nodes.
+func (r *ntpDNSSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState - function to import the NTP, DNS and timezone settings of PowerFlex Manager.
+func (r *ntpDNSSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("out_of_sync_nodes"), types.ListValueMust(types.StringType, nil))...)
+}
+
+// apply sets the settings on PowerFlex Manager and on the cluster nodes
+func (r *ntpDNSSettingsResource) apply(ctx context.Context, plan models.NtpDNSSettingsResourceModel) (diags diag.Diagnostics) {
+	settings, dgs := helper.GetTimeSettingsPayload(ctx, plan)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return
+	}
+
+	err := helper.SetManagerTimeSettings(r.gatewayClient, r.client, settings)
+	if err != nil {
+		diags.AddError(
+			"Error setting NTP, DNS and timezone of PowerFlex Manager",
+			err.Error(),
+		)
+		return
+	}
+
+	nodeParam, dgs := helper.GetNodeTimeSettingsParam(ctx, plan)
+	diags.Append(dgs...)
+	if diags.HasError() || nodeParam == nil {
+		return
+	}
+
+	// nodes are configured with the resulting settings of PowerFlex Manager, so that unchanged settings are propagated as well
+	nodeParam.Settings, err = helper.GetManagerTimeSettings(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error in getting NTP, DNS and timezone of PowerFlex Manager",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Setting NTP, DNS and timezone of the cluster nodes")
+	err = helper.SetNodeTimeSettings(r.gatewayClient, r.client, nodeParam)
+	if err != nil {
+		diags.AddError(
+			"Error setting NTP, DNS and timezone of the cluster nodes",
+			err.Error(),
+		)
+	}
+	return
+}
+
+// read gets the settings of PowerFlex Manager and compares the cluster nodes against them
+func (r *ntpDNSSettingsResource) read(ctx context.Context, plan models.NtpDNSSettingsResourceModel) (models.NtpDNSSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings, err := helper.GetManagerTimeSettings(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error in getting NTP, DNS and timezone of PowerFlex Manager",
+			err.Error(),
+		)
+		return plan, diags
+	}
+
+	outOfSyncNodes := make([]string, 0)
+	nodeParam, dgs := helper.GetNodeTimeSettingsParam(ctx, plan)
+	diags.Append(dgs...)
+	if nodeParam != nil {
+		nodes, err := helper.GetNodeTimeSettings(r.gatewayClient, r.client, nodeParam)
+		if err != nil {
+			diags.AddError(
+				"Error in getting NTP, DNS and timezone of the cluster nodes",
+				err.Error(),
+			)
+			return plan, diags
+		}
+		outOfSyncNodes = helper.GetOutOfSyncNodes(settings, nodes)
+	}
+
+	state, dgs := helper.UpdateNtpDNSSettingsState(plan, settings, outOfSyncNodes)
+	diags.Append(dgs...)
+	return state, diags
+}
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NtpDNSSettingsResourceSchema defines the schema for NTP DNS settings resource
+var NtpDNSSettingsResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to manage the NTP servers, DNS servers and timezone of PowerFlex Manager and, through LIA, of the cluster nodes." +
+		" Settings which drift from the configuration are reported and updated on the next apply." +
+		" This resource supports Create, Update, Delete and Import operations. Delete only removes the resource from the state.",
+	MarkdownDescription: "This resource is used to manage the NTP servers, DNS servers and timezone of PowerFlex Manager and, through LIA, of the cluster nodes." +
+		" Settings which drift from the configuration are reported and updated on the next apply." +
+		" This resource supports Create, Update, Delete and Import operations. Delete only removes the resource from the state.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the NTP DNS settings.",
+			MarkdownDescription: "ID of the NTP DNS settings.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_servers": schema.ListAttribute{
+			Description:         "List of NTP servers.",
+			MarkdownDescription: "List of NTP servers.",
+			Required:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"dns_servers": schema.ListAttribute{
+			Description:         "List of DNS servers. If not specified, DNS servers are not changed.",
+			MarkdownDescription: "List of DNS servers. If not specified, DNS servers are not changed.",
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"timezone": schema.StringAttribute{
+			Description:         "Timezone in the IANA format, for example `UTC` or `America/New_York`. If not specified, timezone is not changed.",
+			MarkdownDescription: "Timezone in the IANA format, for example `UTC` or `America/New_York`. If not specified, timezone is not changed.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"cluster_nodes": schema.SingleNestedAttribute{
+			Description:         "Cluster nodes on which the settings are applied through LIA.",
+			MarkdownDescription: "Cluster nodes on which the settings are applied through LIA.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"mdm_ip": schema.StringAttribute{
+					Description:         "IP of the primary MDM of the cluster.",
+					MarkdownDescription: "IP of the primary MDM of the cluster.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"mdm_password": schema.StringAttribute{
+					Description:         "MDM Password",
+					MarkdownDescription: "MDM Password",
+					Required:            true,
+					Sensitive:           true,
+				},
+				"lia_password": schema.StringAttribute{
+					Description:         "Lia Password",
+					MarkdownDescription: "Lia Password",
+					Required:            true,
+					Sensitive:           true,
+				},
+				"node_ips": schema.ListAttribute{
+					Description:         "List of IPs of the nodes on which the settings are applied.",
+					MarkdownDescription: "List of IPs of the nodes on which the settings are applied.",
+					Required:            true,
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+						listvalidator.UniqueValues(),
+						listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					},
+				},
+			},
+		},
+		"out_of_sync_nodes": schema.ListAttribute{
+			Description:         "List of IPs of the cluster nodes whose settings differ from PowerFlex Manager.",
+			MarkdownDescription: "List of IPs of the cluster nodes whose settings differ from PowerFlex Manager.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	},
+}
@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var ntpDNSSettingsConfig = `
+resource "powerflex_ntp_dns_settings" "test" {
+	ntp_servers = ["10.10.10.1"]
+	dns_servers = ["10.10.10.2"]
+	timezone = "UTC"
+}
+`
+
+var ntpDNSSettingsNodesConfig = `
+resource "powerflex_ntp_dns_settings" "test" {
+	ntp_servers = ["10.10.10.1"]
+	dns_servers = ["10.10.10.2"]
+	timezone = "UTC"
+	cluster_nodes = {
+		mdm_ip = "10.10.10.10"
+		mdm_password = "Password"
+		lia_password = "Password"
+		node_ips = ["10.10.10.11", "10.10.10.12"]
+	}
+}
+`
+
+var ntpDNSSettingsEmptyNtpConfig = `
+resource "powerflex_ntp_dns_settings" "test" {
+	ntp_servers = []
+}
+`
+
+var ntpDNSSettingsMock = &helper.TimeSettings{
+	NtpServers: []string{"10.10.10.1"},
+	DNSServers: []string{"10.10.10.2"},
+	Timezone:   "UTC",
+}
+
+// AT
+func TestAccResourceNtpDNSSettings(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Set NTP, DNS and timezone
+			{
+				Config: ProviderConfigForTesting + ntpDNSSettingsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_ntp_dns_settings.test", "ntp_servers.0", "10.10.10.1"),
+					resource.TestCheckResourceAttr("powerflex_ntp_dns_settings.test", "timezone", "UTC"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "powerflex_ntp_dns_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// UT
+func TestAccResourceNtpDNSSettingsUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var getMocker, getNodesMocker, setNodesMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// At least one NTP server is required
+			{
+				Config:      ProviderConfigForTesting + ntpDNSSettingsEmptyNtpConfig,
+				ExpectError: regexp.MustCompile(`.*Attribute ntp_servers list must contain at least 1 elements*.`),
+			},
+			// Error setting the settings of PowerFlex Manager
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetManagerTimeSettings).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ntpDNSSettingsConfig,
+				ExpectError: regexp.MustCompile(`.*Error setting NTP, DNS and timezone of PowerFlex Manager*.`),
+			},
+			// Error setting the settings of the cluster nodes
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetManagerTimeSettings).Return(nil).Build()
+					getMocker = Mock(helper.GetManagerTimeSettings).Return(ntpDNSSettingsMock, nil).Build()
+					setNodesMocker = Mock(helper.SetNodeTimeSettings).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ntpDNSSettingsNodesConfig,
+				ExpectError: regexp.MustCompile(`.*Error setting NTP, DNS and timezone of the cluster nodes*.`),
+			},
+			// Set the settings of PowerFlex Manager and the cluster nodes, one node has drifted
+			{
+				PreConfig: func() {
+					if setNodesMocker != nil {
+						setNodesMocker.UnPatch()
+					}
+					setNodesMocker = Mock(helper.SetNodeTimeSettings).Return(nil).Build()
+					getNodesMocker = Mock(helper.GetNodeTimeSettings).Return([]helper.TimeSettings{
+						{IP: "10.10.10.11", NtpServers: []string{"10.10.10.1"}, DNSServers: []string{"10.10.10.2"}, Timezone: "UTC"},
+						{IP: "10.10.10.12", NtpServers: []string{"10.10.10.3"}, DNSServers: []string{"10.10.10.2"}, Timezone: "UTC"},
+					}, nil).Build()
+				},
+				Config: ProviderConfigForTesting + ntpDNSSettingsNodesConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_ntp_dns_settings.test", "ntp_servers.0", "10.10.10.1"),
+					resource.TestCheckResourceAttr("powerflex_ntp_dns_settings.test", "out_of_sync_nodes.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_ntp_dns_settings.test", "out_of_sync_nodes.0", "10.10.10.12"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if getMocker != nil {
+				getMocker.UnPatch()
+			}
+			if getNodesMocker != nil {
+				getNodesMocker.UnPatch()
+			}
+			if setNodesMocker != nil {
+				setNodesMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestNtpDNSSettingsOutOfSyncNodes(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	expected := &helper.TimeSettings{
+		NtpServers: []string{"10.10.10.1", "ntp.example.com"},
+		DNSServers: []string{"10.10.10.2", "10.10.10.3"},
+		Timezone:   "UTC",
+	}
+	nodes := []helper.TimeSettings{
+		// same servers listed in another order, case and spacing
+		{IP: "10.10.10.11", NtpServers: []string{" NTP.example.com", "10.10.10.1"}, DNSServers: []string{"10.10.10.3", "10.10.10.2"}, Timezone: "UTC"},
+		{IP: "10.10.10.12", NtpServers: []string{"10.10.10.1"}, DNSServers: []string{"10.10.10.3", "10.10.10.2"}, Timezone: "UTC"},
+		{IP: "10.10.10.13", NtpServers: []string{"ntp.example.com", "10.10.10.1"}, DNSServers: []string{"10.10.10.2"}, Timezone: "UTC"},
+	}
+	outOfSync := helper.GetOutOfSyncNodes(expected, nodes)
+	if len(outOfSync) != 2 || outOfSync[0] != "10.10.10.12" || outOfSync[1] != "10.10.10.13" {
+		t.Fatalf("expected only the nodes with other servers to be out of sync, got %v", outOfSync)
+	}
+
+	// the servers which are not configured are not compared
+	if outOfSync := helper.GetOutOfSyncNodes(&helper.TimeSettings{Timezone: "UTC"}, nodes); len(outOfSync) != 0 {
+		t.Fatalf("expected no node to be out of sync, got %v", outOfSync)
+	}
+}
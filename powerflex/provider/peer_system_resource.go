@@ -88,8 +88,9 @@ func (r *peerSystemResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// If certificate add_certificate is set to true, get the root certificate and add it to the source mdm trust store
+	// using the configured certificate_exchange_method
 	if plan.AddCertificate.ValueBool() {
-		err := helper.AddCertificate(ctx, r.client, plan)
+		err := helper.ExchangeCertificate(ctx, r.client, plan)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error adding certificate to trust store from destination primary mdm to source primary mdm",
@@ -18,6 +18,8 @@ limitations under the License.
 package provider
 
 import (
+	"terraform-provider-powerflex/powerflex/helper"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -83,6 +85,33 @@ var PeerSystemReourceSchema schema.Schema = schema.Schema{
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
 		},
+		"certificate_exchange_method": schema.StringAttribute{
+			Description:         "Method used to exchange the root certificate of the peer mdm destination with the source when add_certificate is set to true. `SSH` runs scli on both primary mdms and requires the ssh and management details of source_primary_mdm_information and destination_primary_mdm_information. `API` fetches the root certificate through the REST API of the destination management instance and requires the management details of destination_primary_mdm_information. `PEM` adds the certificate supplied in destination_root_certificate. Supported in PowerFlex 4.x for `API` and `PEM`. Defaults to `SSH`.",
+			MarkdownDescription: "Method used to exchange the root certificate of the peer mdm destination with the source when add_certificate is set to true. `SSH` runs scli on both primary mdms and requires the ssh and management details of source_primary_mdm_information and destination_primary_mdm_information. `API` fetches the root certificate through the REST API of the destination management instance and requires the management details of destination_primary_mdm_information. `PEM` adds the certificate supplied in destination_root_certificate. Supported in PowerFlex 4.x for `API` and `PEM`. Defaults to `SSH`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(helper.CertificateExchangeSSH),
+			Validators: []validator.String{stringvalidator.OneOf(
+				helper.CertificateExchangeSSH,
+				helper.CertificateExchangeAPI,
+				helper.CertificateExchangePEM,
+			)},
+		},
+		"destination_root_certificate": schema.StringAttribute{
+			Description:         "PEM encoded root certificate of the peer mdm destination. Only used if add_certificate is set to true and certificate_exchange_method is `PEM` during create.",
+			MarkdownDescription: "PEM encoded root certificate of the peer mdm destination. Only used if add_certificate is set to true and certificate_exchange_method is `PEM` during create.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"management_insecure": schema.BoolAttribute{
+			Description:         "Flag that if set to true will skip the TLS verification of the destination management instance. Only used if add_certificate is set to true and certificate_exchange_method is `API` during create.",
+			MarkdownDescription: "Flag that if set to true will skip the TLS verification of the destination management instance. Only used if add_certificate is set to true and certificate_exchange_method is `API` during create.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"source_primary_mdm_information": schema.SingleNestedAttribute{
 			Description:         "Only used if add_certificate is set to true during create. The source primary mdm information to get the root certificate.",
 			MarkdownDescription: "Only used if add_certificate is set to true during create. The source primary mdm information to get the root certificate.",
@@ -85,6 +85,32 @@ resource "powerflex_peer_system" "system_1" {
 }
 `
 
+var PeerSystemCreateAddCertPEM = `
+resource "powerflex_peer_system" "system_1" {  
+    name = "tfacc_peer_mdm_coupling_rc"
+    peer_system_id = "` + DestinationSystemID + `"
+    ip_list = ["` + GatewayDataPoints.primaryMDMIP + `","` + GatewayDataPoints.secondaryMDMIP + `","` + GatewayDataPoints.tbIP + `"] 
+    add_certificate = true
+    certificate_exchange_method = "PEM"
+    destination_root_certificate = "invalid-certificate"
+}
+`
+
+var PeerSystemCreateAddCertAPI = `
+resource "powerflex_peer_system" "system_1" {  
+    name = "tfacc_peer_mdm_coupling_rc"
+    peer_system_id = "` + DestinationSystemID + `"
+    ip_list = ["` + GatewayDataPoints.primaryMDMIP + `","` + GatewayDataPoints.secondaryMDMIP + `","` + GatewayDataPoints.tbIP + `"] 
+    add_certificate = true
+    certificate_exchange_method = "API"
+    destination_primary_mdm_information = {
+        management_ip = ""
+        management_username = ""
+        management_password = ""
+   }
+}
+`
+
 var PeerSystemUpdate = `
 resource "powerflex_peer_system" "system_1" {  
     name = "tfacc_peer_update"
@@ -312,6 +338,37 @@ func TestAccResourcePeerSystemA(t *testing.T) {
 				Config:      ProviderConfigForTesting + PeerSystemCreateAddCert,
 				ExpectError: regexp.MustCompile(`.*Error adding certificate to trust store from destination primary mdm to source primary mdm*.`),
 			},
+			// Add Cert using PEM Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config:      ProviderConfigForTesting + PeerSystemCreateAddCertPEM,
+				ExpectError: regexp.MustCompile(`.*not a valid PEM encoded certificate*.`),
+			},
+			// Add Cert using API Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+				},
+				Config:      ProviderConfigForTesting + PeerSystemCreateAddCertAPI,
+				ExpectError: regexp.MustCompile(`.*management_password of destination_primary_mdm_information are required*.`),
+			},
+			// Add Cert using API extract Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.AddCertificateUsingAPI).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + PeerSystemCreateAddCertAPI,
+				ExpectError: regexp.MustCompile(`.*Error adding certificate to trust store from destination primary mdm to source primary mdm*.`),
+			},
 			// Create successfully
 			{
 				PreConfig: func() {
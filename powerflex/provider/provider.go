@@ -295,6 +295,13 @@ func (p *powerflexProvider) DataSources(_ context.Context) []func() datasource.D
 		PeerMdmDataSource,
 		NvmeTargetDataSource,
 		ResourceCredentialDataSource,
+		LicenseDataSource,
+		SupportAssistDataSource,
+		ReplicationHealthDataSource,
+		ReplicationJournalCapacityDataSource,
+		ReplicationConsistencyGroupSnapshotDataSource,
+		NetworkDataSource,
+		FirmwareRepositoryCompareDataSource,
 	}
 }
 
@@ -328,5 +335,16 @@ func (p *powerflexProvider) Resources(_ context.Context) []func() resource.Resou
 		NewNvmeTargetResource,
 		ResourceCredentialResource,
 		TemplateCloneResource,
+		NewLicenseResource,
+		NewSupportAssistResource,
+		NewNtpDNSSettingsResource,
+		ReplicationPairsResource,
+		NewClusterUpgradeResource,
+		NewDiscoveryResource,
+		NewTemplateResource,
+		NewNetworkResource,
+		NewResourceGroupRemediationResource,
+		NewSdsMaintenanceResource,
+		NewNodeInventoryRefreshResource,
 	}
 }
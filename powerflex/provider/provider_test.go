@@ -301,6 +301,7 @@ var NVMeTargetIP1 = setDefault(globalEnvMap["POWERFLEX_NVME_TARGET_IP1"], "172.1
 var NVMeTargetIP2 = setDefault(globalEnvMap["POWERFLEX_NVME_TARGET_IP2"], "172.169.3.23")
 var TemplateName = setDefault(globalEnvMap["POWERFLEX_TEMPLATE_NAME"], "block-only")
 var OriginalTemplateID = setDefault(globalEnvMap["POWERFLEX_ORIGINAL_TEMPLATE_ID"], "de0874f9-5f40-4eaf-b0ae-c91b2aecbdb7")
+var LicenseFilePath = setDefault(globalEnvMap["POWERFLEX_LICENSE_FILE_PATH"], "tfacc_license_file_path")
 
 func getEnvMap() map[string]string {
 	envMap, err := loadEnvFile("powerflex.env")
@@ -25,7 +25,10 @@ import (
 	"terraform-provider-powerflex/powerflex/models"
 
 	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -87,7 +90,14 @@ func (r *replicationConsistencyGroupResourceAction) Create(ctx context.Context,
 		return
 	}
 
-	err := helper.RCGDoAction(r.client, plan)
+	var err error
+	snapshotGroupID := ""
+	isSnapshot := plan.Action.ValueString() == constants.Snapshot
+	if isSnapshot {
+		snapshotGroupID, err = helper.CreateRCGSnapshot(r.client, plan.ID.ValueString())
+	} else {
+		err = helper.RCGDoAction(r.client, plan)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error doing action %s on replication consistency group", plan.Action.ValueString()),
@@ -96,6 +106,61 @@ func (r *replicationConsistencyGroupResourceAction) Create(ctx context.Context,
 		return
 	}
 
+	var rcg *scaleiotypes.ReplicationConsistencyGroup
+	// The snapshots can only be read from the target system once their creation is complete
+	readSnapshots := isSnapshot && !plan.TargetSystem.IsNull()
+	if plan.WaitForCompletion.ValueBool() || readSnapshots {
+		rcg, err = helper.WaitForRCGAction(ctx, r.client, plan.ID.ValueString(), plan.Action.ValueString(), plan.Timeout.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error waiting for action %s on replication consistency group", plan.Action.ValueString()),
+				err.Error(),
+			)
+			// The action was already sent, save the state so that it is not sent again on the next apply
+			if rcg != nil {
+				plan = helper.UpdateRCGActionState(plan, rcg)
+				plan.SnapshotGroupID = types.StringNull()
+				if isSnapshot {
+					plan.SnapshotGroupID = types.StringValue(snapshotGroupID)
+				}
+				plan.SnapshotIDs = types.MapNull(types.StringType)
+				resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			}
+			return
+		}
+	} else {
+		rcg, err = helper.GetSpecificReplicationConsistencyGroup(r.client, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting replication consistency group after action",
+				err.Error(),
+			)
+			return
+		}
+	}
+	plan = helper.UpdateRCGActionState(plan, rcg)
+
+	plan.SnapshotGroupID = types.StringNull()
+	plan.SnapshotIDs = types.MapNull(types.StringType)
+	if isSnapshot {
+		plan.SnapshotGroupID = types.StringValue(snapshotGroupID)
+	}
+	if readSnapshots {
+		var target models.PeerSystemConnectionModel
+		resp.Diagnostics.Append(plan.TargetSystem.As(ctx, &target, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.SnapshotIDs, err = helper.GetRCGSnapshotIDs(ctx, target, rcg.RemoteID, snapshotGroupID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error getting snapshots from the target system",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	diagsState := resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diagsState...)
 }
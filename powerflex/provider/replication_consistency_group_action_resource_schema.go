@@ -20,10 +20,17 @@ package provider
 import (
 	"terraform-provider-powerflex/powerflex/constants"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ReplicationConsistencyGroupActionReourceSchema - variable holds schema for ReplicationConsistencyGroupAction resource
@@ -49,7 +56,102 @@ var ReplicationConsistencyGroupActionReourceSchema schema.Schema = schema.Schema
 				constants.Reverse,
 				constants.Switchover,
 				constants.Snapshot,
+				constants.TestFailover,
+				constants.TestFailoverStop,
 			)},
 		},
+		"wait_for_completion": schema.BoolAttribute{
+			Description: "Whether to wait until the replication consistency group reaches the state expected after the action." +
+				" Failover and Switchover wait for the failover type to be the action and the failover state to be Done, TestFailover waits for the failover state to be Done," +
+				" Restore, Reverse and TestFailoverStop wait for the failover type and the failover state to be None," +
+				" Sync waits for the consistency mode to be Consistent and Snapshot waits until the snapshot creation is complete.",
+			MarkdownDescription: "Whether to wait until the replication consistency group reaches the state expected after the action." +
+				" `Failover` and `Switchover` wait for the failover type to be the action and the failover state to be `Done`, `TestFailover` waits for the failover state to be `Done`," +
+				" `Restore`, `Reverse` and `TestFailoverStop` wait for the failover type and the failover state to be `None`," +
+				" `Sync` waits for the consistency mode to be `Consistent` and `Snapshot` waits until the snapshot creation is complete.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"timeout": schema.Int64Attribute{
+			Description: "Time in minutes to wait for the action to complete. Applicable only when wait_for_completion is true." +
+				" On timeout the resource is saved as tainted with the last known states of the replication consistency group.",
+			MarkdownDescription: "Time in minutes to wait for the action to complete. Applicable only when `wait_for_completion` is true." +
+				" On timeout the resource is saved as tainted with the last known states of the replication consistency group.",
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(10),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"failover_type":                  rcgActionStateAttribute("Failover type of the replication consistency group after the action."),
+		"failover_state":                 rcgActionStateAttribute("Failover state of the replication consistency group after the action."),
+		"disaster_recovery_state":        rcgActionStateAttribute("Disaster recovery state of the replication consistency group after the action."),
+		"remote_disaster_recovery_state": rcgActionStateAttribute("Remote disaster recovery state of the replication consistency group after the action."),
+		"curr_consist_mode":              rcgActionStateAttribute("Current consistency mode of the replication consistency group after the action."),
+		"abstract_state":                 rcgActionStateAttribute("Abstract state of the replication consistency group after the action."),
+		"snapshot_group_id":              rcgActionStateAttribute("ID of the snapshot group created on the target system by the Snapshot action."),
+		"snapshot_ids": schema.MapAttribute{
+			Description: "Map of the source volume ID to the ID of its snapshot on the target system, created by the Snapshot action." +
+				" Only set when target_system is configured.",
+			MarkdownDescription: "Map of the source volume ID to the ID of its snapshot on the target system, created by the `Snapshot` action." +
+				" Only set when `target_system` is configured.",
+			ElementType: types.StringType,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"target_system": schema.SingleNestedAttribute{
+			Description: "Connection details of the target system of the replication consistency group." +
+				" Used by the Snapshot action to read the IDs of the created snapshots, the action then waits until the snapshot creation is complete.",
+			MarkdownDescription: "Connection details of the target system of the replication consistency group." +
+				" Used by the `Snapshot` action to read the IDs of the created snapshots, the action then waits until the snapshot creation is complete.",
+			Optional:   true,
+			Attributes: peerSystemConnectionAttributes,
+		},
+	},
+}
+
+// peerSystemConnectionAttributes are the attributes used to connect to the peer system
+var peerSystemConnectionAttributes = map[string]schema.Attribute{
+	"endpoint": schema.StringAttribute{
+		Description:         "Endpoint of the peer system, eg: https://10.1.1.1:443",
+		MarkdownDescription: "Endpoint of the peer system, eg: https://10.1.1.1:443",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"username": schema.StringAttribute{
+		Description:         "Username of the peer system.",
+		MarkdownDescription: "Username of the peer system.",
+		Required:            true,
+	},
+	"password": schema.StringAttribute{
+		Description:         "Password of the peer system.",
+		MarkdownDescription: "Password of the peer system.",
+		Required:            true,
+		Sensitive:           true,
+	},
+	"insecure": schema.BoolAttribute{
+		Description:         "Specifies if the connection to the peer system is made over an insecure TLS connection.",
+		MarkdownDescription: "Specifies if the connection to the peer system is made over an insecure TLS connection.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
 	},
 }
+
+// rcgActionStateAttribute returns a computed attribute holding a state of the RCG after the action
+func rcgActionStateAttribute(desc string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         desc,
+		MarkdownDescription: desc,
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
@@ -21,11 +21,16 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-powerflex/powerflex/constants"
 	"terraform-provider-powerflex/powerflex/helper"
 	"testing"
 
 	. "github.com/bytedance/mockey"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var ReplicationConsistencyGroupActionResourceConfigFailover = `
@@ -98,6 +103,51 @@ resource "powerflex_replication_consistency_group_action" "example" {
 }
 `
 
+var ReplicationConsistencyGroupActionResourceConfigTestFailoverWait = `
+resource "powerflex_replication_consistency_group_action" "example" {
+    id = "` + RcgID + `"
+
+    # Action to be performed on the replication consistency group.
+    # Options are Failover, Restore, Sync, Reverse, Switchover, Snapshot, TestFailover and TestFailoverStop (Default is Sync)
+    action = "TestFailover"
+
+    # Wait until the failover state of the replication consistency group is Done
+    wait_for_completion = true
+    timeout = 5
+}
+`
+
+var ReplicationConsistencyGroupActionResourceConfigTestFailoverStopWait = `
+resource "powerflex_replication_consistency_group_action" "example" {
+    id = "` + RcgID + `"
+
+    # Action to be performed on the replication consistency group.
+    # Options are Failover, Restore, Sync, Reverse, Switchover, Snapshot, TestFailover and TestFailoverStop (Default is Sync)
+    action = "TestFailoverStop"
+
+    # Wait until the failover state of the replication consistency group is None
+    wait_for_completion = true
+}
+`
+
+var ReplicationConsistencyGroupActionResourceConfigSnapshotTarget = `
+resource "powerflex_replication_consistency_group_action" "example" {
+    id = "` + RcgID + `"
+
+    # Action to be performed on the replication consistency group.
+    # Options are Failover, Restore, Sync, Reverse, Switchover, Snapshot, TestFailover and TestFailoverStop (Default is Sync)
+    action = "Snapshot"
+
+    # Read the IDs of the snapshots created on the target system
+    target_system = {
+        endpoint = "https://10.10.10.10"
+        username = "user"
+        password = "password"
+        insecure = true
+    }
+}
+`
+
 // Accptance Tests
 func TestAccResourceAcceptanceReplicationConsistencyGroupActions(t *testing.T) {
 	if os.Getenv("TF_ACC") != "1" {
@@ -121,7 +171,192 @@ func TestAccResourceAcceptanceReplicationConsistencyGroupActions(t *testing.T) {
 	})
 }
 
+// Accptance Tests
+func TestAccResourceAcceptanceReplicationConsistencyGroupTestFailover(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Test Failover
+			{
+				Config: ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigTestFailoverWait,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "failover_state", "Done"),
+				),
+			},
+		},
+	})
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Test Failover Stop
+			{
+				Config: ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigTestFailoverStopWait,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "failover_state", "None"),
+				),
+			},
+		},
+	})
+}
+
+// Unit Tests
+func TestAccResourceReplicationConsistencyGroupSnapshot(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var waitMocker, snapshotsMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error creating the snapshot
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateRCGSnapshot).Return("", fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigSnapshotTarget,
+				ExpectError: regexp.MustCompile(`.*Error doing action Snapshot on replication consistency group*.`),
+			},
+			// Error getting the snapshots from the target system
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateRCGSnapshot).Return("sg-1", nil).Build()
+					waitMocker = Mock(helper.WaitForRCGAction).Return(&scaleiotypes.ReplicationConsistencyGroup{
+						ID:       RcgID,
+						RemoteID: "remote-rcg-1",
+					}, nil).Build()
+					snapshotsMocker = Mock(helper.GetRCGSnapshotIDs).Return(types.MapNull(types.StringType), fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigSnapshotTarget,
+				ExpectError: regexp.MustCompile(`.*Error getting snapshots from the target system*.`),
+			},
+			// Snapshot with the IDs of the snapshots on the target system
+			{
+				PreConfig: func() {
+					if snapshotsMocker != nil {
+						snapshotsMocker.UnPatch()
+					}
+					snapshotsMocker = Mock(helper.GetRCGSnapshotIDs).Return(types.MapValueMust(types.StringType, map[string]attr.Value{
+						"vol-1": types.StringValue("snap-1"),
+						"vol-2": types.StringValue("snap-2"),
+					}), nil).Build()
+				},
+				Config: ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigSnapshotTarget,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "snapshot_group_id", "sg-1"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "snapshot_ids.%", "2"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "snapshot_ids.vol-1", "snap-1"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			for _, m := range []*Mocker{waitMocker, snapshotsMocker} {
+				if m != nil {
+					m.UnPatch()
+				}
+			}
+			return nil
+		},
+	})
+}
+
 // Unit Tests
+func TestAccResourceReplicationConsistencyGroupWaitForCompletion(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var waitMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error waiting for the action
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.RCGDoAction).Return(nil).Build()
+					waitMocker = Mock(helper.WaitForRCGAction).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigTestFailoverWait,
+				ExpectError: regexp.MustCompile(`.*Error waiting for action TestFailover on replication consistency group*.`),
+			},
+			// Test Failover with the final states of the RCG
+			{
+				PreConfig: func() {
+					if waitMocker != nil {
+						waitMocker.UnPatch()
+					}
+					waitMocker = Mock(helper.WaitForRCGAction).Return(&scaleiotypes.ReplicationConsistencyGroup{
+						ID:                    RcgID,
+						FailoverType:          "TestFailover",
+						FailoverState:         "Done",
+						DisasterRecoveryState: "None",
+						CurrConsistMode:       "Consistent",
+					}, nil).Build()
+				},
+				Config: ProviderConfigForTesting + ReplicationConsistencyGroupActionResourceConfigTestFailoverWait,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "failover_type", "TestFailover"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "failover_state", "Done"),
+					resource.TestCheckResourceAttr("powerflex_replication_consistency_group_action.example", "curr_consist_mode", "Consistent"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if waitMocker != nil {
+				waitMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+func TestIsRCGActionComplete(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	tests := []struct {
+		action  string
+		rcg     scaleiotypes.ReplicationConsistencyGroup
+		done    bool
+		wantErr bool
+	}{
+		{constants.TestFailover, scaleiotypes.ReplicationConsistencyGroup{FailoverState: "Done"}, true, false},
+		{constants.Failover, scaleiotypes.ReplicationConsistencyGroup{FailoverState: "Failed"}, false, true},
+		{constants.TestFailoverStop, scaleiotypes.ReplicationConsistencyGroup{FailoverState: "Done"}, false, false},
+		{constants.Restore, scaleiotypes.ReplicationConsistencyGroup{FailoverType: "None", FailoverState: "None"}, true, false},
+		{constants.Reverse, scaleiotypes.ReplicationConsistencyGroup{FailoverType: "Failover", FailoverState: "Done"}, false, false},
+		{constants.Switchover, scaleiotypes.ReplicationConsistencyGroup{FailoverType: "Switchover", FailoverState: "Done"}, true, false},
+		{constants.Switchover, scaleiotypes.ReplicationConsistencyGroup{FailoverType: "None", FailoverState: "Done"}, false, false},
+		{constants.Sync, scaleiotypes.ReplicationConsistencyGroup{CurrConsistMode: "Consistent"}, true, false},
+		{constants.Snapshot, scaleiotypes.ReplicationConsistencyGroup{SnapCreationInProgress: true}, false, false},
+	}
+	for _, tt := range tests {
+		done, err := helper.IsRCGActionComplete(tt.action, &tt.rcg)
+		if (err != nil) != tt.wantErr || done != tt.done {
+			t.Errorf("IsRCGActionComplete(%s) = %v, %v, want %v, error %v", tt.action, done, err, tt.done, tt.wantErr)
+		}
+	}
+}
 func TestAccResourceReplicationConsistencyGroupActions(t *testing.T) {
 	if os.Getenv("TF_ACC") == "1" {
 		t.Skip("Dont run with units tests, this is an Acceptance test")
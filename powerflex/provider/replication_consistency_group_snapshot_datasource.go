@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &replicationConsistencyGroupSnapshotDataSource{}
+	_ datasource.DataSourceWithConfigure = &replicationConsistencyGroupSnapshotDataSource{}
+)
+
+// ReplicationConsistencyGroupSnapshotDataSource returns the replication consistency group snapshot data source
+func ReplicationConsistencyGroupSnapshotDataSource() datasource.DataSource {
+	return &replicationConsistencyGroupSnapshotDataSource{}
+}
+
+type replicationConsistencyGroupSnapshotDataSource struct {
+	client *goscaleio.Client
+}
+
+func (d *replicationConsistencyGroupSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_consistency_group_snapshot"
+}
+
+func (d *replicationConsistencyGroupSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ReplicationConsistencyGroupSnapshotDataSourceSchema
+}
+
+func (d *replicationConsistencyGroupSnapshotDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	d.client = req.ProviderData.(*powerflexProvider).client
+}
+
+func (d *replicationConsistencyGroupSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.ReplicationConsistencyGroupSnapshotDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := helper.GetRCGSnapshots(d.client, state.ReplicationConsistencyGroupID.ValueString(), state.SnapshotGroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting Replication Consistency Group snapshots",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("replication_consistency_group_snapshot")
+	state.Snapshots = snapshots
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
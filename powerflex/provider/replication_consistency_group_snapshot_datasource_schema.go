@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ReplicationConsistencyGroupSnapshotDataSourceSchema defines the schema for replication consistency group snapshot datasource
+var ReplicationConsistencyGroupSnapshotDataSourceSchema schema.Schema = schema.Schema{
+	Description: "This datasource is used to read the snapshots of the volumes of a Replication Consistency Group on the target system of the PowerFlex Array." +
+		" The snapshots are created on the target system by the Snapshot action of the powerflex_replication_consistency_group_action resource." +
+		" The provider must be configured with the target system and the ID must be the one of the Replication Consistency Group on the target system." +
+		" This feature is only supported for PowerFlex 4.5 and above.",
+	MarkdownDescription: "This datasource is used to read the snapshots of the volumes of a Replication Consistency Group on the target system of the PowerFlex Array." +
+		" The snapshots are created on the target system by the `Snapshot` action of the `powerflex_replication_consistency_group_action` resource." +
+		" The provider must be configured with the target system and the ID must be the one of the Replication Consistency Group on the target system." +
+		" This feature is only supported for PowerFlex 4.5 and above.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "default datasource id",
+			MarkdownDescription: "default datasource id",
+			Computed:            true,
+		},
+		"replication_consistency_group_id": schema.StringAttribute{
+			Description:         "ID of the replication consistency group on the target system.",
+			MarkdownDescription: "ID of the replication consistency group on the target system.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"snapshot_group_id": schema.StringAttribute{
+			Description:         "ID of the snapshot group. If set, only the snapshots of this snapshot group are returned.",
+			MarkdownDescription: "ID of the snapshot group. If set, only the snapshots of this snapshot group are returned.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"snapshots": schema.ListNestedAttribute{
+			Description:         "List of snapshots of the volumes of the replication consistency group.",
+			MarkdownDescription: "List of snapshots of the volumes of the replication consistency group.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "ID of the snapshot.",
+						MarkdownDescription: "ID of the snapshot.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the snapshot.",
+						MarkdownDescription: "Name of the snapshot.",
+						Computed:            true,
+					},
+					"snapshot_group_id": schema.StringAttribute{
+						Description:         "ID of the snapshot group of the snapshot.",
+						MarkdownDescription: "ID of the snapshot group of the snapshot.",
+						Computed:            true,
+					},
+					"volume_id": schema.StringAttribute{
+						Description:         "ID of the replicated volume on the target system.",
+						MarkdownDescription: "ID of the replicated volume on the target system.",
+						Computed:            true,
+					},
+					"volume_name": schema.StringAttribute{
+						Description:         "Name of the replicated volume on the target system.",
+						MarkdownDescription: "Name of the replicated volume on the target system.",
+						Computed:            true,
+					},
+					"source_volume_id": schema.StringAttribute{
+						Description:         "ID of the source volume of the replication pair.",
+						MarkdownDescription: "ID of the source volume of the replication pair.",
+						Computed:            true,
+					},
+					"replication_pair_id": schema.StringAttribute{
+						Description:         "ID of the replication pair.",
+						MarkdownDescription: "ID of the replication pair.",
+						Computed:            true,
+					},
+					"size_in_kb": schema.Int64Attribute{
+						Description:         "Size of the snapshot in KB.",
+						MarkdownDescription: "Size of the snapshot in KB.",
+						Computed:            true,
+					},
+					"creation_time": schema.Int64Attribute{
+						Description:         "Creation time of the snapshot.",
+						MarkdownDescription: "Creation time of the snapshot.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var ReplicationConsistencyGroupSnapshotRead = `
+data "powerflex_replication_consistency_group_snapshot" "snapshots" {
+    replication_consistency_group_id = "` + RcgID + `"
+}
+`
+
+var ReplicationConsistencyGroupSnapshotReadGroup = `
+data "powerflex_replication_consistency_group_snapshot" "snapshots" {
+    replication_consistency_group_id = "` + RcgID + `"
+    snapshot_group_id = "sg-1"
+}
+`
+
+// Accptance Tests
+func TestAccDatasourceAcceptanceReplicationConsistencyGroupSnapshot(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + ReplicationConsistencyGroupSnapshotRead,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_replication_consistency_group_snapshot.snapshots", "snapshots.#"),
+				),
+			},
+		},
+	})
+}
+
+// Unit Tests
+func TestAccDatasourceReplicationConsistencyGroupSnapshot(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with Acceptance tests, this is a Unit test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error getting the snapshots
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetRCGSnapshots).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationConsistencyGroupSnapshotRead,
+				ExpectError: regexp.MustCompile(`.*Error in getting Replication Consistency Group snapshots*.`),
+			},
+			// Snapshots of a snapshot group
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetRCGSnapshots).Return([]models.ReplicationConsistencyGroupSnapshotModel{
+						{
+							ID:                types.StringValue("snap-1"),
+							Name:              types.StringValue("snap-1"),
+							SnapshotGroupID:   types.StringValue("sg-1"),
+							VolumeID:          types.StringValue("target-vol-1"),
+							VolumeName:        types.StringValue("target-vol-1"),
+							SourceVolumeID:    types.StringValue("vol-1"),
+							SizeInKb:          types.Int64Value(8388608),
+							CreationTime:      types.Int64Value(1700000000),
+							ReplicationPairID: types.StringValue("pair-1"),
+						},
+					}, nil).Build()
+				},
+				Config: ProviderConfigForTesting + ReplicationConsistencyGroupSnapshotReadGroup,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_replication_consistency_group_snapshot.snapshots", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_consistency_group_snapshot.snapshots", "snapshots.0.id", "snap-1"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_consistency_group_snapshot.snapshots", "snapshots.0.source_volume_id", "vol-1"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
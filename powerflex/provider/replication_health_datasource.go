@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &replicationHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &replicationHealthDataSource{}
+)
+
+// ReplicationHealthDataSource returns the replication health data source
+func ReplicationHealthDataSource() datasource.DataSource {
+	return &replicationHealthDataSource{}
+}
+
+type replicationHealthDataSource struct {
+	client *goscaleio.Client
+}
+
+func (d *replicationHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_health"
+}
+
+func (d *replicationHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ReplicationHealthDataSourceSchema
+}
+
+func (d *replicationHealthDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	d.client = req.ProviderData.(*powerflexProvider).client
+}
+
+func (d *replicationHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.ReplicationHealthDataSourceModel
+	// Get the state incase filters are set
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get All RCGs
+	rcgs, err := helper.GetReplicationConsistencyGroups(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting Replication Consistency Groups details",
+			err.Error(),
+		)
+		return
+	}
+	// Set state for filters
+	if state.ReplicationConsistencyGroupFilter != nil {
+		filtered, err := helper.GetDataSourceByValue(*state.ReplicationConsistencyGroupFilter, rcgs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error in filtering Replication Consistency Groups: %v please validate the filter", state.ReplicationConsistencyGroupFilter), err.Error(),
+			)
+			return
+		}
+		filteredRcgs := []scaleiotypes.ReplicationConsistencyGroup{}
+		for _, val := range filtered {
+			filteredRcgs = append(filteredRcgs, val.(scaleiotypes.ReplicationConsistencyGroup))
+		}
+		rcgs = filteredRcgs
+	}
+
+	compliant := true
+	journals := make(map[string]*helper.ProtectionDomainJournal)
+	state.ReplicationHealthDetails = []models.ReplicationHealthModel{}
+	for _, rcg := range rcgs {
+		health, err := helper.GetReplicationHealth(d.client, rcg, journals)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in getting Replication Consistency Group health",
+				err.Error(),
+			)
+			return
+		}
+		compliant = compliant && health.Compliant.ValueBool()
+		state.ReplicationHealthDetails = append(state.ReplicationHealthDetails, *health)
+	}
+
+	state.ID = types.StringValue("replication_health")
+	state.Compliant = types.BoolValue(compliant)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
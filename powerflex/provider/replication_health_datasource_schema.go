@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ReplicationHealthDataSourceSchema defines the schema for replication health datasource
+var ReplicationHealthDataSourceSchema schema.Schema = schema.Schema{
+	Description: "This datasource is used to read the health and RPO compliance of the Replication Consistency Groups of the PowerFlex Array. This feature is only supported for PowerFlex 4.5 and above." +
+		" A Replication Consistency Group is compliant when its lag does not exceed its RPO, it is neither in error nor inactive, the initial copy of its replication pairs is complete" +
+		" and the used journal capacity of its protection domain has not reached the replication capacity max ratio of the protection domain capacity.",
+	MarkdownDescription: "This datasource is used to read the health and RPO compliance of the Replication Consistency Groups of the PowerFlex Array. This feature is only supported for PowerFlex 4.5 and above." +
+		" A Replication Consistency Group is compliant when its lag does not exceed its RPO, it is neither in error nor inactive, the initial copy of its replication pairs is complete" +
+		" and the used journal capacity of its protection domain has not reached the replication capacity max ratio of the protection domain capacity.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "default datasource id",
+			MarkdownDescription: "default datasource id",
+			Computed:            true,
+		},
+		"compliant": schema.BoolAttribute{
+			Description:         "Whether all the Replication Consistency Groups are compliant.",
+			MarkdownDescription: "Whether all the Replication Consistency Groups are compliant.",
+			Computed:            true,
+		},
+		"replication_health_details": schema.ListNestedAttribute{
+			Description:         "List of Replication Consistency Group health",
+			MarkdownDescription: "List of Replication Consistency Group health",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: replicationHealthAttributes,
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"filter": schema.SingleNestedBlock{
+			Attributes: helper.GenerateSchemaAttributes(helper.TypeToMap(models.ReplicationConsistencyGroupFilter{})),
+		},
+	},
+}
+
+var replicationHealthAttributes map[string]schema.Attribute = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Description:         "Unique identifier of the replication consistency group instance.",
+		MarkdownDescription: "Unique identifier of the replication consistency group instance.",
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		Description:         "Name of the replication consistency group instance.",
+		MarkdownDescription: "Name of the replication consistency group instance.",
+		Computed:            true,
+	},
+	"rpo_in_seconds": schema.Int64Attribute{
+		Description:         "RPO of the replication consistency group in seconds.",
+		MarkdownDescription: "RPO of the replication consistency group in seconds.",
+		Computed:            true,
+	},
+	"lag_in_seconds": schema.Int64Attribute{
+		Description:         "Current lag of the replication consistency group in seconds.",
+		MarkdownDescription: "Current lag of the replication consistency group in seconds.",
+		Computed:            true,
+	},
+	"abstract_state": schema.StringAttribute{
+		Description:         "Abstract state of the replication consistency group.",
+		MarkdownDescription: "Abstract state of the replication consistency group.",
+		Computed:            true,
+	},
+	"error": schema.Int64Attribute{
+		Description:         "Error code of the replication consistency group.",
+		MarkdownDescription: "Error code of the replication consistency group.",
+		Computed:            true,
+	},
+	"inactive_reason": schema.Int64Attribute{
+		Description:         "Inactive reason of the replication consistency group.",
+		MarkdownDescription: "Inactive reason of the replication consistency group.",
+		Computed:            true,
+	},
+	"initial_copy_complete": schema.BoolAttribute{
+		Description:         "Whether the initial copy of all the replication pairs of the replication consistency group is complete.",
+		MarkdownDescription: "Whether the initial copy of all the replication pairs of the replication consistency group is complete.",
+		Computed:            true,
+	},
+	"protection_domain_id": schema.StringAttribute{
+		Description:         "Protection domain ID of the replication consistency group.",
+		MarkdownDescription: "Protection domain ID of the replication consistency group.",
+		Computed:            true,
+	},
+	"journal_capacity_max_ratio": schema.Int64Attribute{
+		Description:         "Replication capacity max ratio of the protection domain.",
+		MarkdownDescription: "Replication capacity max ratio of the protection domain.",
+		Computed:            true,
+	},
+	"journal_capacity_in_kb": schema.Int64Attribute{
+		Description:         "Journal capacity of the protection domain in KB.",
+		MarkdownDescription: "Journal capacity of the protection domain in KB.",
+		Computed:            true,
+	},
+	"journal_used_in_kb": schema.Int64Attribute{
+		Description:         "Used journal capacity of the protection domain in KB.",
+		MarkdownDescription: "Used journal capacity of the protection domain in KB.",
+		Computed:            true,
+	},
+	"journal_usage_percent": schema.Float64Attribute{
+		Description:         "Journal usage of the protection domain in percent of the journal capacity.",
+		MarkdownDescription: "Journal usage of the protection domain in percent of the journal capacity.",
+		Computed:            true,
+	},
+	"journal_used_ratio": schema.Float64Attribute{
+		Description:         "Used journal capacity in percent of the capacity of the protection domain. The replication consistency group is not compliant when it reaches journal_capacity_max_ratio.",
+		MarkdownDescription: "Used journal capacity in percent of the capacity of the protection domain. The replication consistency group is not compliant when it reaches `journal_capacity_max_ratio`.",
+		Computed:            true,
+	},
+	"compliant": schema.BoolAttribute{
+		Description:         "Whether the replication consistency group is compliant.",
+		MarkdownDescription: "Whether the replication consistency group is compliant.",
+		Computed:            true,
+	},
+	"non_compliant_reasons": schema.ListAttribute{
+		Description:         "Reasons for which the replication consistency group is not compliant.",
+		MarkdownDescription: "Reasons for which the replication consistency group is not compliant.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	},
+	"replication_pairs": schema.ListNestedAttribute{
+		Description:         "Initial copy progress of the replication pairs of the replication consistency group.",
+		MarkdownDescription: "Initial copy progress of the replication pairs of the replication consistency group.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description:         "Unique identifier of the replication pair instance.",
+					MarkdownDescription: "Unique identifier of the replication pair instance.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					Description:         "Name of the replication pair instance.",
+					MarkdownDescription: "Name of the replication pair instance.",
+					Computed:            true,
+				},
+				"initial_copy_state": schema.StringAttribute{
+					Description:         "Initial Copy State of the replication pair instance.",
+					MarkdownDescription: "Initial Copy State of the replication pair instance.",
+					Computed:            true,
+				},
+				"initial_copy_progress": schema.Float64Attribute{
+					Description:         "Initial Copy Progress of the replication pair instance in percent.",
+					MarkdownDescription: "Initial Copy Progress of the replication pair instance in percent.",
+					Computed:            true,
+				},
+			},
+		},
+	},
+}
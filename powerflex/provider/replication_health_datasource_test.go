@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var ReplicationHealthReadAll = `
+data "powerflex_replication_health" "health" {}
+`
+
+var ReplicationHealthReadFilter = `
+data "powerflex_replication_health" "health" {
+    filter {
+        name = ["rcg-1"]
+    }
+}
+`
+
+// Accptance Tests
+func TestAccDatasourceAcceptanceReplicationHealth(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + ReplicationHealthReadAll,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_replication_health.health", "compliant"),
+				),
+			},
+		},
+	})
+}
+
+// Unit Tests
+func TestAccDatasourceReplicationHealth(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with Acceptance tests, this is a Unit test")
+	}
+	var statsMocker, journalMocker, pairsMocker, progressMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Error getting the RCGs
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetReplicationConsistencyGroups).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationHealthReadAll,
+				ExpectError: regexp.MustCompile(`.*Error in getting Replication Consistency Groups details*.`),
+			},
+			// Error getting the statistics of the RCG
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetReplicationConsistencyGroups).Return([]scaleiotypes.ReplicationConsistencyGroup{
+						{ID: "rcg-1", Name: "rcg-1", RpoInSeconds: 60, ProtectionDomainID: "pd-1"},
+						{ID: "rcg-2", Name: "rcg-2", RpoInSeconds: 30, ProtectionDomainID: "pd-1"},
+					}, nil).Build()
+					statsMocker = Mock(helper.GetRCGStatistics).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationHealthReadAll,
+				ExpectError: regexp.MustCompile(`.*Error in getting Replication Consistency Group health*.`),
+			},
+			// Lag of rcg-2 exceeds its RPO and the initial copy is in progress
+			{
+				PreConfig: func() {
+					if statsMocker != nil {
+						statsMocker.UnPatch()
+					}
+					statsMocker = Mock(helper.GetRCGStatistics).Return(&helper.ReplicationConsistencyGroupStatistics{CurrentLagInSeconds: 45}, nil).Build()
+					journalMocker = Mock(helper.GetProtectionDomainJournal).Return(&helper.ProtectionDomainJournal{
+						CapacityMaxRatio: 10,
+						Statistics: helper.ProtectionDomainJournalStatistics{
+							TotalJournalCapacity: 1000,
+							UsedJournalCapacity:  600,
+							MaxCapacity:          5000,
+						},
+					}, nil).Build()
+					pairsMocker = Mock(helper.GetRCGReplicationPairs).Return([]*scaleiotypes.ReplicationPair{
+						{ID: "pair-1", Name: "pair-1", InitialCopyState: "Done"},
+						{ID: "pair-2", Name: "pair-2", InitialCopyState: "InProgress"},
+					}, nil).Build()
+					progressMocker = Mock(helper.GetReplicationPairProgress).Return(42.5, nil).Build()
+				},
+				Config: ProviderConfigForTesting + ReplicationHealthReadAll,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "compliant", "false"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.#", "2"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.lag_in_seconds", "45"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.journal_usage_percent", "60"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.journal_used_ratio", "12"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.initial_copy_complete", "false"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.replication_pairs.1.initial_copy_progress", "42.5"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.1.non_compliant_reasons.0", "lag of 45 seconds exceeds the RPO of 30 seconds"),
+				),
+			},
+			// Journal usage reaches the replication capacity max ratio
+			{
+				PreConfig: func() {
+					if pairsMocker != nil {
+						pairsMocker.UnPatch()
+					}
+					pairsMocker = Mock(helper.GetRCGReplicationPairs).Return([]*scaleiotypes.ReplicationPair{
+						{ID: "pair-1", Name: "pair-1", InitialCopyState: "Done"},
+					}, nil).Build()
+				},
+				Config: ProviderConfigForTesting + ReplicationHealthReadFilter,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "compliant", "false"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.non_compliant_reasons.#", "1"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_health.health", "replication_health_details.0.non_compliant_reasons.0", "journal usage of 12.00% of the protection domain capacity reaches the replication capacity max ratio of 10%"),
+				),
+			},
+			// Initial copy of the replication pairs is complete
+			{
+				Config: ProviderConfigForTesting + ReplicationHealthReadFilter + `
+					output "initial_copy_complete" {
+						value = data.powerflex_replication_health.health.replication_health_details[0].initial_copy_complete
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("initial_copy_complete", "true"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			for _, m := range []*Mocker{statsMocker, journalMocker, pairsMocker, progressMocker} {
+				if m != nil {
+					m.UnPatch()
+				}
+			}
+			return nil
+		},
+	})
+}
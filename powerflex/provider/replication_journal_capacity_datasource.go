@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &replicationJournalCapacityDataSource{}
+	_ datasource.DataSourceWithConfigure = &replicationJournalCapacityDataSource{}
+)
+
+// ReplicationJournalCapacityDataSource returns the replication journal capacity data source
+func ReplicationJournalCapacityDataSource() datasource.DataSource {
+	return &replicationJournalCapacityDataSource{}
+}
+
+type replicationJournalCapacityDataSource struct {
+	client *goscaleio.Client
+}
+
+func (d *replicationJournalCapacityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_journal_capacity"
+}
+
+func (d *replicationJournalCapacityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ReplicationJournalCapacityDataSourceSchema
+}
+
+func (d *replicationJournalCapacityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	d.client = req.ProviderData.(*powerflexProvider).client
+}
+
+func (d *replicationJournalCapacityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state models.ReplicationJournalCapacityDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pools, err := helper.GetStoragePoolCapacities(d.client, state.ProtectionDomainID.ValueString(), state.ProtectionDomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting storage pool capacity",
+			err.Error(),
+		)
+		return
+	}
+
+	// The journal must hold the writes for the RPO and for the tolerated outage
+	duration := state.RpoInSeconds.ValueInt64() + state.OutageToleranceInMinutes.ValueInt64()*60
+	required := helper.GetRequiredJournalCapacityInKb(state.WriteBandwidthInMBPerSecond.ValueFloat64(), duration)
+
+	sufficient := true
+	state.StoragePools = helper.GetJournalCapacityRecommendation(pools, required)
+	for _, pool := range state.StoragePools {
+		if pool.Sufficient.ValueBool() {
+			continue
+		}
+		sufficient = false
+		if pool.RecommendedPercentage.ValueInt64() >= 100 {
+			resp.Diagnostics.AddWarning(
+				"Replication journal capacity cannot be satisfied",
+				fmt.Sprintf("The protection domain does not have enough capacity to hold %d KB of journal, storage pool %s would need more than 100%% of its capacity.", required, pool.Name.ValueString()),
+			)
+			continue
+		}
+		resp.Diagnostics.AddWarning(
+			"Replication journal capacity is too low",
+			fmt.Sprintf("Storage pool %s has replication_journal_capacity set to %d%%, the recommended value is at least %d%%.",
+				pool.Name.ValueString(), pool.ConfiguredPercentage.ValueInt64(), pool.RecommendedPercentage.ValueInt64()),
+		)
+	}
+
+	state.ID = types.StringValue("replication_journal_capacity")
+	state.RequiredJournalCapacityInKb = types.Int64Value(required)
+	state.Sufficient = types.BoolValue(sufficient)
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
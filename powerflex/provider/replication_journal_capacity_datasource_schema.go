@@ -0,0 +1,147 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ReplicationJournalCapacityDataSourceSchema defines the schema for replication journal capacity datasource
+var ReplicationJournalCapacityDataSourceSchema schema.Schema = schema.Schema{
+	Description: "This datasource is used to plan the replication journal capacity of the storage pools of a protection domain of the PowerFlex Array." +
+		" The journal must hold the writes to the replicated volumes of the protection domain for the RPO and for the outage that should be tolerated." +
+		" The required journal capacity is spread over the storage pools in proportion to their capacity and a warning is returned for every storage pool" +
+		" whose replication_journal_capacity is lower than the recommended percentage.",
+	MarkdownDescription: "This datasource is used to plan the replication journal capacity of the storage pools of a protection domain of the PowerFlex Array." +
+		" The journal must hold the writes to the replicated volumes of the protection domain for the RPO and for the outage that should be tolerated." +
+		" The required journal capacity is spread over the storage pools in proportion to their capacity and a warning is returned for every storage pool" +
+		" whose `replication_journal_capacity` is lower than the recommended percentage.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "default datasource id",
+			MarkdownDescription: "default datasource id",
+			Computed:            true,
+		},
+		"protection_domain_id": schema.StringAttribute{
+			Description:         "ID of the protection domain. Conflicts with protection_domain_name.",
+			MarkdownDescription: "ID of the protection domain. Conflicts with `protection_domain_name`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+				stringvalidator.ExactlyOneOf(path.MatchRoot("protection_domain_name")),
+			},
+		},
+		"protection_domain_name": schema.StringAttribute{
+			Description:         "Name of the protection domain. Conflicts with protection_domain_id.",
+			MarkdownDescription: "Name of the protection domain. Conflicts with `protection_domain_id`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"write_bandwidth_in_mb_per_second": schema.Float64Attribute{
+			Description:         "Expected peak write bandwidth in MB/s to the replicated volumes of the protection domain.",
+			MarkdownDescription: "Expected peak write bandwidth in MB/s to the replicated volumes of the protection domain.",
+			Required:            true,
+			Validators: []validator.Float64{
+				float64validator.AtLeast(0),
+			},
+		},
+		"rpo_in_seconds": schema.Int64Attribute{
+			Description:         "RPO in seconds of the replication consistency groups of the protection domain.",
+			MarkdownDescription: "RPO in seconds of the replication consistency groups of the protection domain.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+				int64validator.AtLeastOneOf(path.MatchRoot("outage_tolerance_in_minutes")),
+			},
+		},
+		"outage_tolerance_in_minutes": schema.Int64Attribute{
+			Description:         "Duration in minutes of an outage of the link or of the peer system during which the writes must be kept in the journal.",
+			MarkdownDescription: "Duration in minutes of an outage of the link or of the peer system during which the writes must be kept in the journal.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+		"required_journal_capacity_in_kb": schema.Int64Attribute{
+			Description:         "Journal capacity in KB required in the protection domain.",
+			MarkdownDescription: "Journal capacity in KB required in the protection domain.",
+			Computed:            true,
+		},
+		"sufficient": schema.BoolAttribute{
+			Description:         "Whether the configured journal capacity of all the storage pools is sufficient.",
+			MarkdownDescription: "Whether the configured journal capacity of all the storage pools is sufficient.",
+			Computed:            true,
+		},
+		"storage_pools": schema.ListNestedAttribute{
+			Description:         "List of the configured and recommended journal capacity of the storage pools.",
+			MarkdownDescription: "List of the configured and recommended journal capacity of the storage pools.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "Storage pool ID.",
+						MarkdownDescription: "Storage pool ID.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Storage pool name.",
+						MarkdownDescription: "Storage pool name.",
+						Computed:            true,
+					},
+					"capacity_in_kb": schema.Int64Attribute{
+						Description:         "Capacity of the storage pool in KB.",
+						MarkdownDescription: "Capacity of the storage pool in KB.",
+						Computed:            true,
+					},
+					"configured_percentage": schema.Int64Attribute{
+						Description:         "Configured replication journal capacity in percent of the storage pool capacity.",
+						MarkdownDescription: "Configured replication journal capacity in percent of the storage pool capacity.",
+						Computed:            true,
+					},
+					"configured_journal_capacity_in_kb": schema.Int64Attribute{
+						Description:         "Configured replication journal capacity in KB.",
+						MarkdownDescription: "Configured replication journal capacity in KB.",
+						Computed:            true,
+					},
+					"recommended_percentage": schema.Int64Attribute{
+						Description:         "Recommended replication journal capacity in percent of the storage pool capacity.",
+						MarkdownDescription: "Recommended replication journal capacity in percent of the storage pool capacity.",
+						Computed:            true,
+					},
+					"recommended_journal_capacity_in_kb": schema.Int64Attribute{
+						Description:         "Recommended replication journal capacity in KB.",
+						MarkdownDescription: "Recommended replication journal capacity in KB.",
+						Computed:            true,
+					},
+					"sufficient": schema.BoolAttribute{
+						Description:         "Whether the configured replication journal capacity is at least the recommended one.",
+						MarkdownDescription: "Whether the configured replication journal capacity is at least the recommended one.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
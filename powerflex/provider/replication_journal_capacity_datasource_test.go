@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var ReplicationJournalCapacityRead = `
+data "powerflex_replication_journal_capacity" "journal" {
+    protection_domain_id = "` + ProtectionDomainID + `"
+    write_bandwidth_in_mb_per_second = 10
+    rpo_in_seconds = 60
+    outage_tolerance_in_minutes = 5
+}
+`
+
+var ReplicationJournalCapacityReadHighBandwidth = `
+data "powerflex_replication_journal_capacity" "journal" {
+    protection_domain_id = "` + ProtectionDomainID + `"
+    write_bandwidth_in_mb_per_second = 200
+    outage_tolerance_in_minutes = 6
+}
+`
+
+var ReplicationJournalCapacityReadInvalid = `
+data "powerflex_replication_journal_capacity" "journal" {
+    protection_domain_id = "` + ProtectionDomainID + `"
+    protection_domain_name = "pd-1"
+    write_bandwidth_in_mb_per_second = 10
+    rpo_in_seconds = 60
+}
+`
+
+// Accptance Tests
+func TestAccDatasourceAcceptanceReplicationJournalCapacity(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfigForTesting + ReplicationJournalCapacityRead,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_replication_journal_capacity.journal", "required_journal_capacity_in_kb"),
+					resource.TestCheckResourceAttrSet("data.powerflex_replication_journal_capacity.journal", "sufficient"),
+				),
+			},
+		},
+	})
+}
+
+// Unit Tests
+func TestAccDatasourceReplicationJournalCapacity(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with Acceptance tests, this is a Unit test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Both protection domain id and name are set
+			{
+				Config:      ProviderConfigForTesting + ReplicationJournalCapacityReadInvalid,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// Error getting the storage pools
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetStoragePoolCapacities).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationJournalCapacityRead,
+				ExpectError: regexp.MustCompile(`.*Error in getting storage pool capacity*.`),
+			},
+			// Journal capacity of pool-2 is too low
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetStoragePoolCapacities).Return([]helper.StoragePoolCapacity{
+						{ID: "pool-1", Name: "pool-1", CapacityInKb: 10000000, JournalCapacityMaxRatio: 10},
+						{ID: "pool-2", Name: "pool-2", CapacityInKb: 30000000, JournalCapacityMaxRatio: 5},
+					}, nil).Build()
+				},
+				Config: ProviderConfigForTesting + ReplicationJournalCapacityRead,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "required_journal_capacity_in_kb", "3686400"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "sufficient", "false"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.#", "2"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.0.recommended_percentage", "10"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.0.recommended_journal_capacity_in_kb", "1000000"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.0.sufficient", "true"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.1.configured_journal_capacity_in_kb", "1500000"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.1.sufficient", "false"),
+				),
+			},
+			// Protection domain cannot hold the required journal
+			{
+				Config: ProviderConfigForTesting + ReplicationJournalCapacityReadHighBandwidth,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "required_journal_capacity_in_kb", "73728000"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.0.recommended_percentage", "100"),
+					resource.TestCheckResourceAttr("data.powerflex_replication_journal_capacity.journal", "storage_pools.0.sufficient", "false"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
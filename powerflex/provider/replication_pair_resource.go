@@ -26,6 +26,8 @@ import (
 	"github.com/dell/goscaleio"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -88,12 +90,49 @@ func (r *replicationPairResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	// client of the peer system, set only when the destination volume is created by this resource
+	var peerClient *goscaleio.Client
+	if !plan.DestinationVolume.IsNull() {
+		var dest models.ReplicationPairDestinationVolumeModel
+		resp.Diagnostics.Append(plan.DestinationVolume.As(ctx, &dest, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var err error
+		peerClient, err = helper.GetPeerClient(dest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error connecting to the peer system",
+				err.Error(),
+			)
+			return
+		}
+		destID, err := helper.ProvisionDestinationVolume(ctx, r.client, peerClient, plan.SourceVolumeID.ValueString(), dest)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating destination volume on the peer system",
+				err.Error(),
+			)
+			return
+		}
+		plan.DestinationVolumeID = types.StringValue(destID)
+	}
+
 	createID, createErr := helper.CreateReplicationPair(r.client, plan)
 	if createErr != nil {
 		resp.Diagnostics.AddError(
 			"Error creating replication pair",
 			createErr.Error(),
 		)
+		// remove the destination volume created above, so that it is not left behind on the peer system
+		if peerClient != nil {
+			if err := helper.RemoveDestinationVolume(peerClient, plan.DestinationVolumeID.ValueString()); err != nil {
+				resp.Diagnostics.AddError(
+					"Error removing destination volume from the peer system",
+					err.Error(),
+				)
+			}
+		}
 		return
 	}
 
@@ -255,10 +294,32 @@ func (r *replicationPairResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	// the pair no longer exists, a failed cleanup of the destination volume must not keep it in the state
+	resp.State.RemoveResource(ctx)
+
+	if !state.DestinationVolume.IsNull() {
+		var dest models.ReplicationPairDestinationVolumeModel
+		diags := state.DestinationVolume.As(ctx, &dest, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.AddWarning(
+				"Error removing destination volume from the peer system",
+				"The replication pair is removed but its destination volume could not be read from the state, it must be removed manually from the peer system",
+			)
+			return
+		}
+		if dest.DeleteOnDestroy.ValueBool() {
+			peerClient, err := helper.GetPeerClient(dest)
+			if err == nil {
+				err = helper.RemoveDestinationVolume(peerClient, state.DestinationVolumeID.ValueString())
+			}
+			if err != nil {
+				resp.Diagnostics.AddWarning(
+					"Error removing destination volume from the peer system",
+					"The replication pair is removed but its destination volume "+state.DestinationVolumeID.ValueString()+
+						" must be removed manually from the peer system: "+err.Error(),
+				)
+			}
+		}
 	}
 }
 
@@ -18,8 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // ReplicationPairReourceSchema - variable holds schema for ReplicationPair resource
@@ -38,9 +46,109 @@ var ReplicationPairReourceSchema schema.Schema = schema.Schema{
 			Required:            true,
 		},
 		"destination_volume_id": schema.StringAttribute{
-			Description:         "Destination Volume ID",
-			MarkdownDescription: "Destination Volume ID",
-			Required:            true,
+			Description:         "Destination Volume ID. Exactly one of destination_volume_id and destination_volume is required.",
+			MarkdownDescription: "Destination Volume ID. Exactly one of `destination_volume_id` and `destination_volume` is required.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("destination_volume")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"destination_volume": schema.SingleNestedAttribute{
+			Description: "Details to create the destination volume on the peer system. The volume is created with the same size as the source volume using the credentials of the peer system and is then paired with the source volume." +
+				" Exactly one of destination_volume_id and destination_volume is required.",
+			MarkdownDescription: "Details to create the destination volume on the peer system. The volume is created with the same size as the source volume using the credentials of the peer system and is then paired with the source volume." +
+				" Exactly one of `destination_volume_id` and `destination_volume` is required.",
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"endpoint": schema.StringAttribute{
+					Description:         "Endpoint of the peer system, eg: https://10.1.1.1:443",
+					MarkdownDescription: "Endpoint of the peer system, eg: https://10.1.1.1:443",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"username": schema.StringAttribute{
+					Description:         "Username of the peer system.",
+					MarkdownDescription: "Username of the peer system.",
+					Required:            true,
+				},
+				"password": schema.StringAttribute{
+					Description:         "Password of the peer system.",
+					MarkdownDescription: "Password of the peer system.",
+					Required:            true,
+					Sensitive:           true,
+				},
+				"insecure": schema.BoolAttribute{
+					Description:         "Specifies if the connection to the peer system is made over an insecure TLS connection.",
+					MarkdownDescription: "Specifies if the connection to the peer system is made over an insecure TLS connection.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"protection_domain_name": schema.StringAttribute{
+					Description:         "Name of the protection domain on the peer system in which the destination volume is created.",
+					MarkdownDescription: "Name of the protection domain on the peer system in which the destination volume is created.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"storage_pool_name": schema.StringAttribute{
+					Description:         "Name of the storage pool on the peer system in which the destination volume is created.",
+					MarkdownDescription: "Name of the storage pool on the peer system in which the destination volume is created.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"name_pattern": schema.StringAttribute{
+					Description:         "Name pattern of the destination volume. " + helper.SourceVolumeNamePlaceholder + " is replaced by the name of the source volume.",
+					MarkdownDescription: "Name pattern of the destination volume. `" + helper.SourceVolumeNamePlaceholder + "` is replaced by the name of the source volume.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString(helper.SourceVolumeNamePlaceholder + "_replica"),
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"volume_type": schema.StringAttribute{
+					Description:         "Volume type of the destination volume. Valid values are ThinProvisioned and ThickProvisioned.",
+					MarkdownDescription: "Volume type of the destination volume. Valid values are `ThinProvisioned` and `ThickProvisioned`.",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("ThinProvisioned"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("ThinProvisioned", "ThickProvisioned"),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"delete_on_destroy": schema.BoolAttribute{
+					Description:         "Whether to remove the destination volume from the peer system when the replication pair is destroyed.",
+					MarkdownDescription: "Whether to remove the destination volume from the peer system when the replication pair is destroyed.",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
 		},
 		"replication_consistency_group_id": schema.StringAttribute{
 			Description:         "Replication Consistency Group ID",
@@ -25,7 +25,9 @@ import (
 	"testing"
 
 	. "github.com/bytedance/mockey"
+	"github.com/dell/goscaleio"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 var ReplicationPairCreate = `
@@ -58,6 +60,47 @@ resource "powerflex_replication_pair" "rp" {
 }
 `
 
+var ReplicationPairProvisionDestination = `
+resource "powerflex_replication_pair" "rp" {
+    name                              = "` + RpName + `"
+    source_volume_id                  = "` + RpSourceVolumeID + `"
+    replication_consistency_group_id  = "` + RcgID + `"
+    destination_volume = {
+        endpoint               = "https://10.10.10.10:443"
+        username               = "admin"
+        password               = "Password"
+        insecure               = true
+        protection_domain_name = "domain1"
+        storage_pool_name      = "pool1"
+        name_pattern           = "{source_volume_name}_dr"
+    }
+}
+`
+
+var ReplicationPairBothDestinations = `
+resource "powerflex_replication_pair" "rp" {
+    name                              = "` + RpName + `"
+    source_volume_id                  = "` + RpSourceVolumeID + `"
+    destination_volume_id             = "` + RpDestinationVolumeID + `"
+    replication_consistency_group_id  = "` + RcgID + `"
+    destination_volume = {
+        endpoint               = "https://10.10.10.10:443"
+        username               = "admin"
+        password               = "Password"
+        protection_domain_name = "domain1"
+        storage_pool_name      = "pool1"
+    }
+}
+`
+
+var ReplicationPairNoDestination = `
+resource "powerflex_replication_pair" "rp" {
+    name                              = "` + RpName + `"
+    source_volume_id                  = "` + RpSourceVolumeID + `"
+    replication_consistency_group_id  = "` + RcgID + `"
+}
+`
+
 // Accptance Tests
 func TestAccResourceAcceptanceReplicationPair(t *testing.T) {
 	if os.Getenv("TF_ACC") != "1" {
@@ -188,3 +231,78 @@ func TestAccResourceReplicationPair(t *testing.T) {
 		},
 	})
 }
+
+// Unit Tests
+func TestAccResourceReplicationPairProvisionDestination(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var provisionMocker, createMocker, removeMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Both destination_volume_id and destination_volume cannot be set
+			{
+				Config:      ProviderConfigForTesting + ReplicationPairBothDestinations,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// One of destination_volume_id and destination_volume is required
+			{
+				Config:      ProviderConfigForTesting + ReplicationPairNoDestination,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// Error connecting to the peer system
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetPeerClient).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationPairProvisionDestination,
+				ExpectError: regexp.MustCompile(`.*Error connecting to the peer system*.`),
+			},
+			// Error creating the destination volume
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetPeerClient).Return(&goscaleio.Client{}, nil).Build()
+					provisionMocker = Mock(helper.ProvisionDestinationVolume).Return("", fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationPairProvisionDestination,
+				ExpectError: regexp.MustCompile(`.*Error creating destination volume on the peer system*.`),
+			},
+			// Error creating the replication pair, the destination volume is removed
+			{
+				PreConfig: func() {
+					if provisionMocker != nil {
+						provisionMocker.UnPatch()
+					}
+					provisionMocker = Mock(helper.ProvisionDestinationVolume).Return("mock-volume-id", nil).Build()
+					createMocker = Mock(helper.CreateReplicationPair).Return("", fmt.Errorf("Mock error")).Build()
+					removeMocker = Mock(helper.RemoveDestinationVolume).Return(fmt.Errorf("Mock remove error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationPairProvisionDestination,
+				ExpectError: regexp.MustCompile(`.*Error removing destination volume from the peer system*.`),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if provisionMocker != nil {
+				provisionMocker.UnPatch()
+			}
+			if createMocker != nil {
+				createMocker.UnPatch()
+			}
+			if removeMocker != nil {
+				removeMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
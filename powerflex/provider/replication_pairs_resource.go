@@ -0,0 +1,182 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &replicationPairsResource{}
+	_ resource.ResourceWithConfigure  = &replicationPairsResource{}
+	_ resource.ResourceWithModifyPlan = &replicationPairsResource{}
+)
+
+// ReplicationPairsResource - function to return resource interface
+func ReplicationPairsResource() resource.Resource {
+	return &replicationPairsResource{}
+}
+
+// replicationPairsResource - struct to define ReplicationPairs resource
+type replicationPairsResource struct {
+	client *goscaleio.Client
+}
+
+// Metadata - function to return metadata for ReplicationPairs resource.
+func (r *replicationPairsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_replication_pairs"
+}
+
+// Schema - function to return Schema for ReplicationPairs resource.
+func (r *replicationPairsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ReplicationPairsResourceSchema
+}
+
+// Configure - function to return Configuration for ReplicationPairs resource.
+func (r *replicationPairsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+}
+
+// ModifyPlan - function to plan an update when the set of selected source volumes has changed.
+func (r *replicationPairsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is getting created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state models.ReplicationPairsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !helper.Known(plan.SourceVolumeIDs, plan.SourceVolumeNameRegex) {
+		return
+	}
+
+	changed, diags := helper.IsReplicationPairsSelectionChanged(ctx, r.client, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if changed {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pairs"), types.ListUnknown(types.ObjectType{AttrTypes: helper.ReplicationPairsPairAttrTypes}))...)
+	}
+}
+
+// Create - function to Create for ReplicationPairs resource.
+func (r *replicationPairsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "[POWERFLEX] Create")
+	var plan models.ReplicationPairsResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := helper.ReconcileReplicationPairs(ctx, r.client, plan, map[string]bool{})
+	resp.Diagnostics.Append(diags...)
+	r.setState(ctx, plan, managed, resp.Diagnostics.HasError(), &resp.State, &resp.Diagnostics)
+}
+
+// Read - function to Read for ReplicationPairs resource.
+func (r *replicationPairsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "[POWERFLEX] Read")
+	var state models.ReplicationPairsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := helper.GetManagedReplicationPairIDs(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.setState(ctx, state, managed, false, &resp.State, &resp.Diagnostics)
+}
+
+// Update - function to Update for ReplicationPairs resource.
+func (r *replicationPairsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "[POWERFLEX] Update")
+	var plan, state models.ReplicationPairsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := helper.GetManagedReplicationPairIDs(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags = helper.ReconcileReplicationPairs(ctx, r.client, plan, managed)
+	resp.Diagnostics.Append(diags...)
+	r.setState(ctx, plan, managed, resp.Diagnostics.HasError(), &resp.State, &resp.Diagnostics)
+}
+
+// Delete - function to Delete for ReplicationPairs resource.
+func (r *replicationPairsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "[POWERFLEX] Delete")
+	var state models.ReplicationPairsResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.RemoveReplicationPairs(ctx, r.client, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// setState reads the managed replication pairs and sets them in the state.
+// The state is saved even if reconciling failed partially, so that the pairs which were created are tracked.
+func (r *replicationPairsResource) setState(ctx context.Context, plan models.ReplicationPairsResourceModel, managed map[string]bool, partial bool, st *tfsdk.State, diags *diag.Diagnostics) {
+	state, dgs := helper.UpdateReplicationPairsState(r.client, plan, managed)
+	diags.Append(dgs...)
+	if dgs.HasError() {
+		return
+	}
+	if partial && len(managed) == 0 {
+		return
+	}
+	diags.Append(st.Set(ctx, state)...)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ReplicationPairsResourceSchema - variable holds schema for ReplicationPairs resource
+var ReplicationPairsResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to manage the Replication Pairs of a set of source volumes in a Replication Consistency Group of the PowerFlex Array. This feature is only supported for PowerFlex 4.5 and above." +
+		" The source volumes are selected by IDs or by a name regex. Replication pairs are created for the selected source volumes along with their destination volumes on the peer system," +
+		" and the replication pairs created by this resource are removed when their source volumes are no longer selected. Replication pairs of the Replication Consistency Group which are not selected are left untouched.",
+	MarkdownDescription: "This resource is used to manage the Replication Pairs of a set of source volumes in a Replication Consistency Group of the PowerFlex Array. This feature is only supported for PowerFlex 4.5 and above." +
+		" The source volumes are selected by IDs or by a name regex. Replication pairs are created for the selected source volumes along with their destination volumes on the peer system," +
+		" and the replication pairs created by this resource are removed when their source volumes are no longer selected. Replication pairs of the Replication Consistency Group which are not selected are left untouched.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "Unique identifier of the replication pairs, same as the Replication Consistency Group ID.",
+			MarkdownDescription: "Unique identifier of the replication pairs, same as the Replication Consistency Group ID.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"replication_consistency_group_id": schema.StringAttribute{
+			Description:         "Replication Consistency Group ID. Cannot be updated.",
+			MarkdownDescription: "Replication Consistency Group ID. Cannot be updated.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"source_volume_ids": schema.SetAttribute{
+			Description:         "IDs of the source volumes. Exactly one of source_volume_ids and source_volume_name_regex is required.",
+			MarkdownDescription: "IDs of the source volumes. Exactly one of `source_volume_ids` and `source_volume_name_regex` is required.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.ExactlyOneOf(path.MatchRoot("source_volume_name_regex")),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"source_volume_name_regex": schema.StringAttribute{
+			Description:         "Regex matching the names of the source volumes. Volumes which start or stop matching the regex are detected during plan. Exactly one of source_volume_ids and source_volume_name_regex is required.",
+			MarkdownDescription: "Regex matching the names of the source volumes. Volumes which start or stop matching the regex are detected during plan. Exactly one of `source_volume_ids` and `source_volume_name_regex` is required.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"pair_name_pattern": schema.StringAttribute{
+			Description:         "Name pattern of the replication pairs. " + helper.SourceVolumeNamePlaceholder + " is replaced by the name of the source volume.",
+			MarkdownDescription: "Name pattern of the replication pairs. `" + helper.SourceVolumeNamePlaceholder + "` is replaced by the name of the source volume.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(helper.SourceVolumeNamePlaceholder + "_rp"),
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"paused_source_volume_ids": schema.SetAttribute{
+			Description:         "IDs of the source volumes whose replication pairs have their initial copy paused. The initial copy of the other replication pairs is resumed.",
+			MarkdownDescription: "IDs of the source volumes whose replication pairs have their initial copy paused. The initial copy of the other replication pairs is resumed.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"destination_volume": schema.SingleNestedAttribute{
+			Description:         "Rule to map the source volumes to destination volumes on the peer system. A volume with the same size as the source volume is created on the peer system. An existing volume with the same name is not reused.",
+			MarkdownDescription: "Rule to map the source volumes to destination volumes on the peer system. A volume with the same size as the source volume is created on the peer system. An existing volume with the same name is not reused.",
+			Required:            true,
+			Attributes:          replicationPairDestinationVolumeAttributes,
+		},
+		"pairs": schema.ListNestedAttribute{
+			Description:         "Replication pairs managed by this resource.",
+			MarkdownDescription: "Replication pairs managed by this resource.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "Unique identifier of the replication pair instance.",
+						MarkdownDescription: "Unique identifier of the replication pair instance.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Replication Pair Name",
+						MarkdownDescription: "Replication Pair Name",
+						Computed:            true,
+					},
+					"source_volume_id": schema.StringAttribute{
+						Description:         "Source Volume ID",
+						MarkdownDescription: "Source Volume ID",
+						Computed:            true,
+					},
+					"destination_volume_id": schema.StringAttribute{
+						Description:         "Destination Volume ID",
+						MarkdownDescription: "Destination Volume ID",
+						Computed:            true,
+					},
+					"destination_volume_name": schema.StringAttribute{
+						Description:         "Destination Volume Name",
+						MarkdownDescription: "Destination Volume Name",
+						Computed:            true,
+					},
+					"initial_copy_state": schema.StringAttribute{
+						Description:         "Initial Copy State of the replication pair instance.",
+						MarkdownDescription: "Initial Copy State of the replication pair instance.",
+						Computed:            true,
+					},
+					"paused": schema.BoolAttribute{
+						Description:         "Whether the initial copy of the replication pair is paused.",
+						MarkdownDescription: "Whether the initial copy of the replication pair is paused.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	},
+}
+
+// replicationPairDestinationVolumeAttributes - attributes to create the destination volumes of the replication pairs on the peer system
+var replicationPairDestinationVolumeAttributes = map[string]schema.Attribute{
+	"endpoint": schema.StringAttribute{
+		Description:         "Endpoint of the peer system, eg: https://10.1.1.1:443",
+		MarkdownDescription: "Endpoint of the peer system, eg: https://10.1.1.1:443",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"username": schema.StringAttribute{
+		Description:         "Username of the peer system.",
+		MarkdownDescription: "Username of the peer system.",
+		Required:            true,
+	},
+	"password": schema.StringAttribute{
+		Description:         "Password of the peer system.",
+		MarkdownDescription: "Password of the peer system.",
+		Required:            true,
+		Sensitive:           true,
+	},
+	"insecure": schema.BoolAttribute{
+		Description:         "Specifies if the connection to the peer system is made over an insecure TLS connection.",
+		MarkdownDescription: "Specifies if the connection to the peer system is made over an insecure TLS connection.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	},
+	"protection_domain_name": schema.StringAttribute{
+		Description:         "Name of the protection domain on the peer system in which the destination volume is created.",
+		MarkdownDescription: "Name of the protection domain on the peer system in which the destination volume is created.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"storage_pool_name": schema.StringAttribute{
+		Description:         "Name of the storage pool on the peer system in which the destination volume is created.",
+		MarkdownDescription: "Name of the storage pool on the peer system in which the destination volume is created.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"name_pattern": schema.StringAttribute{
+		Description:         "Name pattern of the destination volume. " + helper.SourceVolumeNamePlaceholder + " is replaced by the name of the source volume.",
+		MarkdownDescription: "Name pattern of the destination volume. `" + helper.SourceVolumeNamePlaceholder + "` is replaced by the name of the source volume.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString(helper.SourceVolumeNamePlaceholder + "_replica"),
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	},
+	"volume_type": schema.StringAttribute{
+		Description:         "Volume type of the destination volume. Valid values are ThinProvisioned and ThickProvisioned.",
+		MarkdownDescription: "Volume type of the destination volume. Valid values are `ThinProvisioned` and `ThickProvisioned`.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("ThinProvisioned"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("ThinProvisioned", "ThickProvisioned"),
+		},
+	},
+	"delete_on_destroy": schema.BoolAttribute{
+		Description:         "Whether to remove the destination volume from the peer system when the replication pair is destroyed.",
+		MarkdownDescription: "Whether to remove the destination volume from the peer system when the replication pair is destroyed.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	},
+}
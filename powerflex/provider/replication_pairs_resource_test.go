@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var ReplicationPairsRegex = `
+resource "powerflex_replication_pairs" "rp" {
+    replication_consistency_group_id = "` + RcgID + `"
+    source_volume_name_regex         = "^app-.*"
+    destination_volume = {
+        endpoint               = "https://10.10.10.10:443"
+        username               = "admin"
+        password               = "Password"
+        insecure               = true
+        protection_domain_name = "domain1"
+        storage_pool_name      = "pool1"
+    }
+}
+`
+
+var ReplicationPairsBothSelectors = `
+resource "powerflex_replication_pairs" "rp" {
+    replication_consistency_group_id = "` + RcgID + `"
+    source_volume_ids                = ["` + RpSourceVolumeID + `"]
+    source_volume_name_regex         = "^app-.*"
+    destination_volume = {
+        endpoint               = "https://10.10.10.10:443"
+        username               = "admin"
+        password               = "Password"
+        protection_domain_name = "domain1"
+        storage_pool_name      = "pool1"
+    }
+}
+`
+
+// Unit Tests
+func TestAccResourceReplicationPairsUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var pairsMocker, removeMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Only one of source_volume_ids and source_volume_name_regex can be set
+			{
+				Config:      ProviderConfigForTesting + ReplicationPairsBothSelectors,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// Error getting the source volumes
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetSelectedSourceVolumes).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationPairsRegex,
+				ExpectError: regexp.MustCompile(`.*Error getting the source volumes*.`),
+			},
+			// The existing replication pair of the selected source volume is not adopted
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetSelectedSourceVolumes).Return([]*scaleiotypes.Volume{
+						{ID: "vol-1", Name: "app-1", SizeInKb: 8388608},
+					}, nil).Build()
+					pairsMocker = Mock(helper.GetRCGReplicationPairs).Return([]*scaleiotypes.ReplicationPair{
+						{ID: "pair-1", Name: "app-1_rp", LocalVolumeID: "vol-1", RemoteVolumeID: "vol-2", RemoteVolumeName: "app-1_replica", InitialCopyState: "Done"},
+						{ID: "pair-2", Name: "other_rp", LocalVolumeID: "vol-3", RemoteVolumeID: "vol-4", RemoteVolumeName: "other_replica", InitialCopyState: "Done"},
+					}, nil).Build()
+					removeMocker = Mock(helper.RemoveReplicationPair).Return(nil).Build()
+				},
+				Config:      ProviderConfigForTesting + ReplicationPairsRegex,
+				ExpectError: regexp.MustCompile(`.*Source volume app-1 is already paired*.`),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if pairsMocker != nil {
+				pairsMocker.UnPatch()
+			}
+			if removeMocker != nil {
+				removeMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,287 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &resourceGroupRemediationResource{}
+	_ resource.ResourceWithConfigure  = &resourceGroupRemediationResource{}
+	_ resource.ResourceWithModifyPlan = &resourceGroupRemediationResource{}
+)
+
+// NewResourceGroupRemediationResource - function to return resource interface
+func NewResourceGroupRemediationResource() resource.Resource {
+	return &resourceGroupRemediationResource{}
+}
+
+// resourceGroupRemediationResource - struct to define resource group remediation resource
+type resourceGroupRemediationResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for resource group remediation resource.
+func (r *resourceGroupRemediationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_group_remediation"
+}
+
+// Schema - function to return Schema for resource group remediation resource.
+func (r *resourceGroupRemediationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ResourceGroupRemediationResourceSchema
+}
+
+// Configure - function to return Configuration for resource group remediation resource.
+func (r *resourceGroupRemediationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// ModifyPlan - function to plan the remediation when its inputs are changed and to report the nodes which are no longer compliant.
+func (r *resourceGroupRemediationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is getting created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.ResourceGroupRemediationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the nodes are remediated again and their compliance is known after the apply
+	if helper.IsRemediationRequested(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("compliant"), types.BoolUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("compliance_summary"), types.ListUnknown(types.ObjectType{AttrTypes: helper.ResourceGroupRemediationNodeAttrTypes}))...)
+		return
+	}
+
+	if !state.Compliant.IsNull() && !state.Compliant.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Nodes of the resource group are not compliant",
+			fmt.Sprintf("Some nodes of resource group %s are no longer compliant with the firmware repository, change triggers to remediate them again.", state.ResourceGroupID.ValueString()),
+		)
+	}
+}
+
+// Create - function to remediate the nodes of the resource group.
+func (r *resourceGroupRemediationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.ResourceGroupRemediationResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.remediate(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the compliance of the nodes of the resource group.
+func (r *resourceGroupRemediationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.ResourceGroupRemediationResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = r.read(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to remediate the nodes of the resource group again when the inputs of the remediation are changed.
+func (r *resourceGroupRemediationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan, state models.ResourceGroupRemediationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.IsRemediationRequested(plan, state) {
+		resp.Diagnostics.Append(r.remediate(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	state, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to remove the remediation from the state.
+// The firmware and software of the nodes are not rolled back.
+func (r *resourceGroupRemediationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	resp.State.RemoveResource(ctx)
+}
+
+// remediate updates the non-compliant nodes of the resource group and waits for the update to complete
+func (r *resourceGroupRemediationResource) remediate(ctx context.Context, plan models.ResourceGroupRemediationResourceModel) (diags diag.Diagnostics) {
+	deploymentID := plan.ResourceGroupID.ValueString()
+	deadline := time.Now().Add(time.Duration(plan.Timeout.ValueInt64()) * time.Minute)
+
+	deployment, err := r.gatewayClient.GetServiceDetailsByID(deploymentID, false)
+	if err != nil {
+		diags.AddError(
+			"Error in getting resource group details",
+			err.Error(),
+		)
+		return
+	}
+	if helper.IsResourceGroupInProgress(deployment.Status) {
+		diags.AddError(
+			"Error remediating resource group",
+			fmt.Sprintf("an operation is already in progress on resource group %s", deployment.DeploymentName),
+		)
+		return
+	}
+
+	reports, err := r.gatewayClient.GetServiceComplianceDetails(deploymentID)
+	if err != nil {
+		diags.AddError(
+			"Error in getting the compliance of the resource group",
+			err.Error(),
+		)
+		return
+	}
+
+	var nodes []string
+	if !plan.Nodes.IsNull() && !plan.Nodes.IsUnknown() {
+		diags.Append(plan.Nodes.ElementsAs(ctx, &nodes, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	targets, err := helper.GetRemediationTargets(reports, nodes)
+	if err != nil {
+		diags.AddError(
+			"Error remediating resource group",
+			err.Error(),
+		)
+		return
+	}
+	if len(targets) == 0 {
+		tflog.Info(ctx, "All the nodes of the resource group are compliant")
+		return
+	}
+
+	// the nodes are updated one at a time for a rolling remediation, otherwise all together.
+	// The whole resource group is updated when no node is selected.
+	batches := make([][]scaleiotypes.ComplianceReport, 0)
+	if plan.Rolling.ValueBool() {
+		for _, target := range targets {
+			batches = append(batches, []scaleiotypes.ComplianceReport{target})
+		}
+	} else {
+		batches = append(batches, targets)
+	}
+
+	for _, batch := range batches {
+		var refIDs []string
+		if plan.Rolling.ValueBool() || len(nodes) > 0 {
+			for _, target := range batch {
+				refIDs = append(refIDs, target.ID)
+			}
+		}
+		tflog.Info(ctx, "Updating the firmware and software of the resource group", map[string]interface{}{"id": deploymentID, "nodes": refIDs})
+		err = helper.StartFirmwareUpdate(r.gatewayClient, r.client, deploymentID, refIDs, plan.ExitMaintenanceMode.ValueBool())
+		if err != nil {
+			diags.AddError(
+				"Error remediating resource group",
+				err.Error(),
+			)
+			return
+		}
+		err = helper.WaitForResourceGroupUpdate(ctx, r.gatewayClient, deploymentID, batch, time.Until(deadline))
+		if err != nil {
+			diags.AddError(
+				"Error remediating resource group",
+				err.Error(),
+			)
+			return
+		}
+	}
+	return
+}
+
+// read gets the compliance of the nodes of the resource group
+func (r *resourceGroupRemediationResource) read(ctx context.Context, plan models.ResourceGroupRemediationResourceModel) (models.ResourceGroupRemediationResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	reports, err := r.gatewayClient.GetServiceComplianceDetails(plan.ResourceGroupID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error in getting the compliance of the resource group",
+			err.Error(),
+		)
+		return plan, diags
+	}
+	return helper.UpdateResourceGroupRemediationState(ctx, plan, reports)
+}
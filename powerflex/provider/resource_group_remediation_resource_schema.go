@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceGroupRemediationResourceSchema defines the schema for resource group remediation resource
+var ResourceGroupRemediationResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to remediate the firmware and software compliance of the nodes of a resource group against its firmware repository." +
+		" The non-compliant nodes are updated by PowerFlex Manager and the compliance of the nodes is returned once the update is completed." +
+		" Nodes which are no longer compliant are reported during plan and are only updated again when `nodes`, `rolling`, `exit_maintenance_mode` or `triggers` are changed." +
+		" This resource supports Create, Update and Delete operations. Delete only removes the resource from the state.",
+	MarkdownDescription: "This resource is used to remediate the firmware and software compliance of the nodes of a resource group against its firmware repository." +
+		" The non-compliant nodes are updated by PowerFlex Manager and the compliance of the nodes is returned once the update is completed." +
+		" Nodes which are no longer compliant are reported during plan and are only updated again when `nodes`, `rolling`, `exit_maintenance_mode` or `triggers` are changed." +
+		" This resource supports Create, Update and Delete operations. Delete only removes the resource from the state.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the remediation, which is the ID of the resource group.",
+			MarkdownDescription: "ID of the remediation, which is the ID of the resource group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"resource_group_id": schema.StringAttribute{
+			Description:         "ID of the resource group to remediate.",
+			MarkdownDescription: "ID of the resource group to remediate.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"nodes": schema.SetAttribute{
+			Description:         "Service tags or IP addresses of the nodes to remediate. If not specified, all the nodes of the resource group are remediated.",
+			MarkdownDescription: "Service tags or IP addresses of the nodes to remediate. If not specified, all the nodes of the resource group are remediated.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"rolling": schema.BoolAttribute{
+			Description:         "Whether to update the nodes one at a time, waiting for each node to complete before updating the next one. Default value is `true`.",
+			MarkdownDescription: "Whether to update the nodes one at a time, waiting for each node to complete before updating the next one. Default value is `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"exit_maintenance_mode": schema.BoolAttribute{
+			Description:         "Whether the nodes exit the maintenance mode once they are updated. Default value is `true`.",
+			MarkdownDescription: "Whether the nodes exit the maintenance mode once they are updated. Default value is `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"timeout": schema.Int64Attribute{
+			Description:         "Describes the time in minutes to timeout the remediation.",
+			MarkdownDescription: "Describes the time in minutes to timeout the remediation.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(180),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Description:         "Arbitrary values which remediate the nodes again when they are changed, for example the version of the firmware repository.",
+			MarkdownDescription: "Arbitrary values which remediate the nodes again when they are changed, for example the version of the firmware repository.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"firmware_repository_name": schema.StringAttribute{
+			Description:         "Name of the firmware repository of the resource group.",
+			MarkdownDescription: "Name of the firmware repository of the resource group.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"compliant": schema.BoolAttribute{
+			Description:         "Whether all the remediated nodes are compliant.",
+			MarkdownDescription: "Whether all the remediated nodes are compliant.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"compliance_summary": schema.ListNestedAttribute{
+			Description:         "Compliance of the remediated nodes.",
+			MarkdownDescription: "Compliance of the remediated nodes.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"service_tag": schema.StringAttribute{
+						Description:         "Service tag of the node.",
+						MarkdownDescription: "Service tag of the node.",
+						Computed:            true,
+					},
+					"ip_address": schema.StringAttribute{
+						Description:         "IP address of the node.",
+						MarkdownDescription: "IP address of the node.",
+						Computed:            true,
+					},
+					"host_name": schema.StringAttribute{
+						Description:         "Host name of the node.",
+						MarkdownDescription: "Host name of the node.",
+						Computed:            true,
+					},
+					"compliant": schema.BoolAttribute{
+						Description:         "Whether the node is compliant.",
+						MarkdownDescription: "Whether the node is compliant.",
+						Computed:            true,
+					},
+					"non_compliant_components": schema.ListAttribute{
+						Description:         "Names of the components of the node which are not compliant.",
+						MarkdownDescription: "Names of the components of the node which are not compliant.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	},
+}
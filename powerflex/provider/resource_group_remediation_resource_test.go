@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var resourceGroupRemediationConfig = `
+resource "powerflex_resource_group_remediation" "test" {
+	resource_group_id = "8aaa03a88de961fa018de96a88d80008"
+	nodes = ["ABCD123"]
+}
+`
+
+var resourceGroupRemediationReportsMock = []scaleiotypes.ComplianceReport{
+	{
+		ID:                     "scaleio-block-legacy-gateway",
+		ServiceTag:             "ABCD123",
+		IPAddress:              "10.10.10.1",
+		HostName:               "node-1",
+		FirmwareRepositoryName: "Intelligent Catalog 45.373.00",
+		DeviceType:             "RackServer",
+		Compliant:              true,
+		CanUpdate:              true,
+	},
+	{
+		ID:         "scaleio-block-legacy-gateway-2",
+		ServiceTag: "ABCD124",
+		IPAddress:  "10.10.10.2",
+		DeviceType: "RackServer",
+		Compliant:  false,
+		CanUpdate:  true,
+		ComplianceReportComponents: []scaleiotypes.ComplianceReportComponents{
+			{Name: "BIOS", Compliant: false},
+			{Name: "iDRAC", Compliant: true},
+		},
+	},
+}
+
+// UT
+func TestAccResourceResourceGroupRemediationUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var reportMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Operation in progress on the resource group
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*goscaleio.GatewayClient).GetServiceDetailsByID).Return(&scaleiotypes.ServiceResponse{Status: "in_progress"}, nil).Build()
+				},
+				Config:      ProviderConfigForTesting + resourceGroupRemediationConfig,
+				ExpectError: regexp.MustCompile(`.*an operation is already in progress*.`),
+			},
+			// Error getting the compliance report
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*goscaleio.GatewayClient).GetServiceDetailsByID).Return(&scaleiotypes.ServiceResponse{Status: "complete"}, nil).Build()
+					reportMocker = Mock((*goscaleio.GatewayClient).GetServiceComplianceDetails).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + resourceGroupRemediationConfig,
+				ExpectError: regexp.MustCompile(`.*Error in getting the compliance of the resource group*.`),
+			},
+			// The selected node is already compliant
+			{
+				PreConfig: func() {
+					if reportMocker != nil {
+						reportMocker.UnPatch()
+					}
+					reportMocker = Mock((*goscaleio.GatewayClient).GetServiceComplianceDetails).Return(resourceGroupRemediationReportsMock, nil).Build()
+				},
+				Config: ProviderConfigForTesting + resourceGroupRemediationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_resource_group_remediation.test", "compliant", "true"),
+					resource.TestCheckResourceAttr("powerflex_resource_group_remediation.test", "firmware_repository_name", "Intelligent Catalog 45.373.00"),
+					resource.TestCheckResourceAttr("powerflex_resource_group_remediation.test", "compliance_summary.#", "1"),
+					resource.TestCheckResourceAttr("powerflex_resource_group_remediation.test", "compliance_summary.0.service_tag", "ABCD123"),
+				),
+			},
+			// The node is no longer compliant, the drift is reported without remediating the node again
+			{
+				PreConfig: func() {
+					if reportMocker != nil {
+						reportMocker.UnPatch()
+					}
+					reports := append([]scaleiotypes.ComplianceReport{}, resourceGroupRemediationReportsMock...)
+					reports[0].Compliant = false
+					reportMocker = Mock((*goscaleio.GatewayClient).GetServiceComplianceDetails).Return(reports, nil).Build()
+				},
+				Config: ProviderConfigForTesting + resourceGroupRemediationConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_resource_group_remediation.test", "compliant", "false"),
+					resource.TestCheckResourceAttr("powerflex_resource_group_remediation.test", "compliance_summary.0.compliant", "false"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if reportMocker != nil {
+				reportMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestResourceGroupRemediationTargets(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+
+	// only the non-compliant nodes are remediated
+	targets, err := helper.GetRemediationTargets(resourceGroupRemediationReportsMock, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 1 || targets[0].ServiceTag != "ABCD124" {
+		t.Fatalf("expected node ABCD124 to be remediated, got %v", targets)
+	}
+
+	// the nodes are selected by service tag or IP address
+	targets, err = helper.GetRemediationTargets(resourceGroupRemediationReportsMock, []string{"10.10.10.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(targets) != 0 {
+		t.Fatalf("expected no node to be remediated, got %v", targets)
+	}
+
+	// the nodes must be part of the resource group
+	if _, err := helper.GetRemediationTargets(resourceGroupRemediationReportsMock, []string{"XYZ"}); err == nil {
+		t.Fatal("expected error for a node which is not part of the resource group")
+	}
+
+	// the non-compliant nodes must be updatable
+	reports := append([]scaleiotypes.ComplianceReport{}, resourceGroupRemediationReportsMock...)
+	reports[1].CanUpdate = false
+	if _, err := helper.GetRemediationTargets(reports, nil); err == nil {
+		t.Fatal("expected error for a node which cannot be updated")
+	}
+}
+
+// UT
+func TestResourceGroupRemediationRequested(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	state := models.ResourceGroupRemediationResourceModel{
+		Nodes:               types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ABCD123")}),
+		Rolling:             types.BoolValue(true),
+		ExitMaintenanceMode: types.BoolValue(true),
+		Timeout:             types.Int64Value(180),
+		Triggers:            types.MapNull(types.StringType),
+		Compliant:           types.BoolValue(false),
+	}
+
+	// a change of the compliance or of the timeout does not remediate the nodes again
+	plan := state
+	plan.Timeout = types.Int64Value(60)
+	plan.Compliant = types.BoolUnknown()
+	if helper.IsRemediationRequested(plan, state) {
+		t.Fatal("expected no remediation when only the timeout is changed")
+	}
+
+	// a change of the triggers remediates the nodes again
+	plan.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{"catalog": types.StringValue("45.373.00")})
+	if !helper.IsRemediationRequested(plan, state) {
+		t.Fatal("expected remediation when the triggers are changed")
+	}
+}
+
+// UT
+func TestResourceGroupComplianceReportChanged(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	before := []scaleiotypes.ComplianceReport{
+		{
+			ID:        "node-1",
+			Compliant: false,
+			ComplianceReportComponents: []scaleiotypes.ComplianceReportComponents{
+				{ID: "bios", Compliant: false, CurrentVersion: scaleiotypes.ComplianceReportComponentVersionInfo{FirmwareVersion: "1.0"}},
+			},
+		},
+	}
+
+	// an update which is not started yet does not change the compliance report
+	after := []scaleiotypes.ComplianceReport{before[0], {ID: "node-2", Compliant: true}}
+	if helper.IsComplianceReportChanged(before, after) {
+		t.Fatal("expected the compliance report not to be changed")
+	}
+
+	// an update completed between two checks changes the current version of the components
+	updated := before[0]
+	updated.ComplianceReportComponents = []scaleiotypes.ComplianceReportComponents{
+		{ID: "bios", Compliant: false, CurrentVersion: scaleiotypes.ComplianceReportComponentVersionInfo{FirmwareVersion: "1.1"}},
+	}
+	if !helper.IsComplianceReportChanged(before, []scaleiotypes.ComplianceReport{updated}) {
+		t.Fatal("expected the updated component version to change the compliance report")
+	}
+	updated = before[0]
+	updated.Compliant = true
+	if !helper.IsComplianceReportChanged(before, []scaleiotypes.ComplianceReport{updated}) {
+		t.Fatal("expected the compliant node to change the compliance report")
+	}
+}
@@ -23,8 +23,11 @@ import (
 	"terraform-provider-powerflex/powerflex/models"
 
 	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -32,6 +35,7 @@ var (
 	_ resource.Resource                = &resourceGroupResource{}
 	_ resource.ResourceWithConfigure   = &resourceGroupResource{}
 	_ resource.ResourceWithImportState = &resourceGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &resourceGroupResource{}
 )
 
 // ResourceGroupResource - function to return resource interface
@@ -76,6 +80,59 @@ func (r *resourceGroupResource) Configure(_ context.Context, req resource.Config
 	}
 }
 
+// ModifyPlan - function to validate the template parameter overrides during plan.
+func (r *resourceGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction. Dont do anything.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan models.ResourceGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// overrides are only applied when the ResourceGroup is deployed, they cannot be added, changed or removed afterwards
+	if !req.State.Raw.IsNull() {
+		var state models.ResourceGroupResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if helper.IsResourceGroupOverridesChanged(state, plan) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("overrides"),
+				"Changing of overrides is not supported",
+				"overrides are only applied when the ResourceGroup is deployed, please validate your inputs",
+			)
+		}
+
+		// a failed deployment is planned for an update, so that it is retried
+		if state.Status.ValueString() == "error" && plan.RetryOnFailure.ValueInt64() > 0 {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		}
+		return
+	}
+
+	overrides, diags := helper.GetResourceGroupOverrides(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(overrides) == 0 || !helper.Known(plan.TemplateID) || r.gatewayClient == nil {
+		return
+	}
+
+	template, err := r.gatewayClient.GetTemplateByID(plan.TemplateID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template_id"),
+			"Error in getting template details to validate overrides",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(helper.ValidateResourceGroupOverrides(template, overrides)...)
+}
+
 // Create - function to Create for ResourceGroup resource.
 func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "[POWERFLEX] Create")
@@ -93,7 +150,19 @@ func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	deploymentResponse, err := r.gatewayClient.DeployService(plan.DeploymentName.ValueString(), plan.DeploymentDescription.ValueString(), plan.TemplateID.ValueString(), plan.FirmwareID.ValueString(), plan.Nodes.String())
+	overrides, diags := helper.GetResourceGroupOverrides(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var deploymentResponse *scaleiotypes.ServiceResponse
+	var err error
+	if len(overrides) > 0 || plan.AdoptExisting.ValueBool() {
+		deploymentResponse, err = helper.DeployResourceGroupWithOverrides(r.gatewayClient, r.client, plan, overrides)
+	} else {
+		deploymentResponse, err = r.gatewayClient.DeployService(plan.DeploymentName.ValueString(), plan.DeploymentDescription.ValueString(), plan.TemplateID.ValueString(), plan.FirmwareID.ValueString(), plan.Nodes.String())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in deploying ResourceGroup",
@@ -102,9 +171,10 @@ func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	deploymentResponse, diag := helper.HandleResourceGroupDeployment(ctx, deploymentResponse, plan, r.gatewayClient)
-	if diag.HasError() {
-		resp.Diagnostics.Append(diag...)
+	deploymentID := deploymentResponse.ID
+	deploymentResponse, dgs := helper.WaitForResourceGroupDeployment(ctx, deploymentResponse, plan, r.gatewayClient, r.client)
+	if dgs.HasError() {
+		r.saveFailedDeployment(ctx, deploymentID, plan, dgs, resp)
 		return
 	}
 
@@ -118,6 +188,32 @@ func (r *resourceGroupResource) Create(ctx context.Context, req resource.CreateR
 
 }
 
+// saveFailedDeployment saves the deployment which still failed after the retries in the state, so that it is not orphaned.
+// With retry_on_failure, the failure is reported as a warning and the next apply retries the deployment, otherwise the ResourceGroup is replaced by the next apply.
+func (r *resourceGroupResource) saveFailedDeployment(ctx context.Context, deploymentID string, plan models.ResourceGroupResourceModel, failure diag.Diagnostics, resp *resource.CreateResponse) {
+	failed, err := r.gatewayClient.GetServiceDetailsByID(deploymentID, false)
+	if err != nil || failed.ID == "" {
+		resp.Diagnostics.Append(failure...)
+		return
+	}
+
+	data, dgs := helper.UpdateResourceGroupState(failed, plan)
+	resp.Diagnostics.Append(dgs...)
+	if dgs.HasError() {
+		resp.Diagnostics.Append(failure...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+
+	if failed.Status == "error" && plan.RetryOnFailure.ValueInt64() > 0 {
+		for _, d := range failure.Errors() {
+			resp.Diagnostics.AddWarning(d.Summary(), d.Detail()+"\nThe failed deployment of ResourceGroup "+deploymentID+" is saved in the state, the next apply retries it.")
+		}
+		return
+	}
+	resp.Diagnostics.Append(failure...)
+}
+
 // Read - function to Read for ResourceGroup resource.
 func (r *resourceGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	tflog.Debug(ctx, "[POWERFLEX] Read")
@@ -177,12 +273,7 @@ func (r *resourceGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	if plan.Nodes.ValueInt64() < state.Nodes.ValueInt64() {
-		resp.Diagnostics.AddError("Removing node(s) is not supported", "please validate your inputs")
-		return
-	}
-
-	if plan.Nodes.String() != state.Nodes.String() && plan.CloneFromHost.ValueString() == "" {
+	if plan.Nodes.ValueInt64() > state.Nodes.ValueInt64() && plan.CloneFromHost.ValueString() == "" {
 		resp.Diagnostics.AddError("Please provide clone_from_host for adding the resource", "please validate your inputs")
 		return
 	}
@@ -197,6 +288,73 @@ func (r *resourceGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	currentDeployment, err := r.gatewayClient.GetServiceDetailsByID(state.ID.ValueString(), false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting ResourceGroup details",
+			err.Error(),
+		)
+		return
+	}
+
+	if currentDeployment.Status == "error" && plan.RetryOnFailure.ValueInt64() > 0 {
+		tflog.Info(ctx, "Retrying failed deployment of ResourceGroup before update")
+		retryResponse, err := helper.RetryResourceGroupDeployment(r.gatewayClient, r.client, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in retrying deployment of ResourceGroup",
+				err.Error(),
+			)
+			return
+		}
+		retryResponse.ID = state.ID.ValueString()
+
+		currentDeployment, diags = helper.WaitForResourceGroupDeployment(ctx, retryResponse, plan, r.gatewayClient, r.client)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	nodeChanges, diags := helper.GetResourceGroupNodeChanges(ctx, currentDeployment, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removedCount := int64(len(nodeChanges.Remove))
+	if plan.Nodes.ValueInt64() < state.Nodes.ValueInt64() && removedCount != state.Nodes.ValueInt64()-plan.Nodes.ValueInt64() {
+		resp.Diagnostics.AddError("Please provide remove_nodes for removing the resource(s)", "the number of nodes in remove_nodes must match the reduction of nodes, please validate your inputs")
+		return
+	}
+
+	if removedCount > 0 && plan.Nodes.ValueInt64() != state.Nodes.ValueInt64()-removedCount {
+		resp.Diagnostics.AddError("Please reduce nodes by the number of nodes in remove_nodes", "please validate your inputs")
+		return
+	}
+
+	if nodeChanges.HasChanges() {
+		tflog.Info(ctx, "Removing and replacing nodes of ResourceGroup", map[string]interface{}{"removed": len(nodeChanges.Remove), "replaced": len(nodeChanges.Replace)})
+		nodesResponse, err := helper.UpdateResourceGroupNodes(r.gatewayClient, r.client, state.ID.ValueString(), nodeChanges)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in updating nodes of ResourceGroup",
+				err.Error(),
+			)
+			return
+		}
+
+		// the state records the accepted teardown, so that a failed deployment does not make the next apply request it again
+		state = helper.RecordResourceGroupNodeChanges(plan, state, nodeChanges)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+
+		_, diag := helper.WaitForResourceGroupDeployment(ctx, nodesResponse, plan, r.gatewayClient, r.client)
+		if diag.HasError() {
+			resp.Diagnostics.Append(diag...)
+			return
+		}
+	}
+
 	deploymentResponse, err := r.gatewayClient.UpdateService(state.ID.ValueString(), plan.DeploymentName.ValueString(), plan.DeploymentDescription.ValueString(), plan.Nodes.String(), plan.CloneFromHost.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -219,7 +377,7 @@ func (r *resourceGroupResource) Update(ctx context.Context, req resource.UpdateR
 
 	}
 
-	deploymentResponse, diag := helper.HandleResourceGroupDeployment(ctx, deploymentResponse, plan, r.gatewayClient)
+	deploymentResponse, diag := helper.WaitForResourceGroupDeployment(ctx, deploymentResponse, plan, r.gatewayClient, r.client)
 	if diag.HasError() {
 		resp.Diagnostics.Append(diag...)
 		return
@@ -245,6 +403,27 @@ func (r *resourceGroupResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	if state.ResetIdrac.ValueBool() || state.DeleteVMs.ValueBool() {
+		tflog.Info(ctx, "Tearing down ResourceGroup before delete", map[string]interface{}{"reset_idrac": state.ResetIdrac.ValueBool(), "delete_vms": state.DeleteVMs.ValueBool()})
+		teardownResponse, err := helper.TeardownResourceGroup(r.gatewayClient, r.client, state.ID.ValueString(), state.ResetIdrac.ValueBool(), state.DeleteVMs.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error in tearing down ResourceGroup",
+				err.Error(),
+			)
+			return
+		}
+
+		if teardownResponse != nil {
+			teardownResponse.ID = state.ID.ValueString()
+			_, diag := helper.HandleResourceGroupDeployment(ctx, teardownResponse, state, r.gatewayClient)
+			if diag.HasError() {
+				resp.Diagnostics.Append(diag...)
+				return
+			}
+		}
+	}
+
 	_, err := r.gatewayClient.DeleteService(state.ID.ValueString(), state.ServersInInventory.ValueString(), state.ServersManagedState.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
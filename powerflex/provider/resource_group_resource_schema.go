@@ -21,12 +21,17 @@ import (
 	"terraform-provider-powerflex/powerflex/helper"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ResourceGroupReourceSchema - variable holds schema for ResourceGroup resource
@@ -83,8 +88,8 @@ var ResourceGroupReourceSchema schema.Schema = schema.Schema{
 			},
 		},
 		"nodes": schema.Int64Attribute{
-			MarkdownDescription: "Number of Nodes",
-			Description:         "Number of Nodes",
+			MarkdownDescription: "Number of Nodes. To add nodes, `clone_from_host` is required. To remove nodes, the nodes to remove must be specified in `remove_nodes`.",
+			Description:         "Number of Nodes. To add nodes, `clone_from_host` is required. To remove nodes, the nodes to remove must be specified in `remove_nodes`.",
 			Optional:            true,
 			Computed:            true,
 			Validators: []validator.Int64{
@@ -94,6 +99,112 @@ var ResourceGroupReourceSchema schema.Schema = schema.Schema{
 				int64planmodifier.UseStateForUnknown(),
 			},
 		},
+		"remove_nodes": schema.SetAttribute{
+			MarkdownDescription: "Service tags or IP addresses of the nodes to remove from the ResourceGroup. PowerFlex Manager removes the SDS of the nodes and migrates their data before the nodes are released. `nodes` must be reduced by the number of nodes to remove. A node which is no longer part of the ResourceGroup is only accepted if it was removed by a previous apply.",
+			Description:         "Service tags or IP addresses of the nodes to remove from the ResourceGroup. PowerFlex Manager removes the SDS of the nodes and migrates their data before the nodes are released. `nodes` must be reduced by the number of nodes to remove. A node which is no longer part of the ResourceGroup is only accepted if it was removed by a previous apply.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"replace_nodes": schema.ListNestedAttribute{
+			MarkdownDescription: "Nodes of the ResourceGroup to replace with nodes of the inventory, for example failed nodes. The replaced node is torn down and a new node is deployed with the same settings. A replacement whose node is no longer part of the ResourceGroup is only accepted if the node was replaced by a previous apply.",
+			Description:         "Nodes of the ResourceGroup to replace with nodes of the inventory, for example failed nodes. The replaced node is torn down and a new node is deployed with the same settings. A replacement whose node is no longer part of the ResourceGroup is only accepted if the node was replaced by a previous apply.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"node": schema.StringAttribute{
+						MarkdownDescription: "Service tag or IP address of the node to replace.",
+						Description:         "Service tag or IP address of the node to replace.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"ref_id": schema.StringAttribute{
+						MarkdownDescription: "Reference ID of the new node in the inventory, for example from `powerflex_discovery`.",
+						Description:         "Reference ID of the new node in the inventory, for example from `powerflex_discovery`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+		"deployed_nodes": schema.ListNestedAttribute{
+			MarkdownDescription: "Nodes deployed by the ResourceGroup.",
+			Description:         "Nodes deployed by the ResourceGroup.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"ref_id": schema.StringAttribute{
+						MarkdownDescription: "Reference ID of the node.",
+						Description:         "Reference ID of the node.",
+						Computed:            true,
+					},
+					"service_tag": schema.StringAttribute{
+						MarkdownDescription: "Service tag of the node.",
+						Description:         "Service tag of the node.",
+						Computed:            true,
+					},
+					"ip_address": schema.StringAttribute{
+						MarkdownDescription: "IP address of the node.",
+						Description:         "IP address of the node.",
+						Computed:            true,
+					},
+					"component_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the component of the node in the ResourceGroup.",
+						Description:         "ID of the component of the node in the ResourceGroup.",
+						Computed:            true,
+					},
+					"status": schema.StringAttribute{
+						MarkdownDescription: "Deployment status of the node.",
+						Description:         "Deployment status of the node.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"overrides": schema.ListNestedAttribute{
+			MarkdownDescription: "Template parameters to override when the ResourceGroup is deployed, for example hostnames, IP addresses, OS credentials or storage pool names. Overrides are validated against the parameter definitions of the template during plan and cannot be added, changed or removed after the deployment.",
+			Description:         "Template parameters to override when the ResourceGroup is deployed, for example hostnames, IP addresses, OS credentials or storage pool names. Overrides are validated against the parameter definitions of the template during plan and cannot be added, changed or removed after the deployment.",
+			Optional:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"component": schema.StringAttribute{
+						MarkdownDescription: "Name of the component of the template.",
+						Description:         "Name of the component of the template.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"parameter": schema.StringAttribute{
+						MarkdownDescription: "ID of the parameter of the component.",
+						Description:         "ID of the parameter of the component.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"value": schema.StringAttribute{
+						MarkdownDescription: "Value of the parameter.",
+						Description:         "Value of the parameter.",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+		},
 		"deployment_timeout": schema.Int64Attribute{
 			MarkdownDescription: "Deployment Timeout, It should be in multiples of 5",
 			Description:         "Deployment Timeout, It should be in multiples of 5",
@@ -145,5 +256,39 @@ var ResourceGroupReourceSchema schema.Schema = schema.Schema{
 				helper.StringDefault("unmanaged"),
 			},
 		},
+		"reset_idrac": schema.BoolAttribute{
+			MarkdownDescription: "On destroy, the nodes are first torn down with their iDRAC configuration reset, and then the ResourceGroup is deleted. Default value is `false`.",
+			Description:         "On destroy, the nodes are first torn down with their iDRAC configuration reset, and then the ResourceGroup is deleted. Default value is `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"delete_vms": schema.BoolAttribute{
+			MarkdownDescription: "On destroy, the ResourceGroup is first torn down with its VMs deleted, and then the ResourceGroup is deleted. Default value is `false`.",
+			Description:         "On destroy, the ResourceGroup is first torn down with its VMs deleted, and then the ResourceGroup is deleted. Default value is `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"retry_on_failure": schema.Int64Attribute{
+			MarkdownDescription: "Number of times a failed deployment is retried with the retry API of PowerFlex Manager. When set, a ResourceGroup whose deployment status is `error` is planned for an update, which retries the failed deployment before applying the other changes. Default value is `0`.",
+			Description:         "Number of times a failed deployment is retried with the retry API of PowerFlex Manager. When set, a ResourceGroup whose deployment status is `error` is planned for an update, which retries the failed deployment before applying the other changes. Default value is `0`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(0),
+			Validators: []validator.Int64{
+				int64validator.Between(0, 5),
+			},
+		},
+		"adopt_existing": schema.BoolAttribute{
+			MarkdownDescription: "Create the ResourceGroup from discovered existing infrastructure instead of deploying it. The nodes of the existing cluster must be discovered and `template_id` must describe the existing cluster. The firmware of the adopted nodes is not updated. Cannot be changed after the creation. Default value is `false`.",
+			Description:         "Create the ResourceGroup from discovered existing infrastructure instead of deploying it. The nodes of the existing cluster must be discovered and `template_id` must describe the existing cluster. The firmware of the adopted nodes is not updated. Cannot be changed after the creation. Default value is `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
 	},
 }
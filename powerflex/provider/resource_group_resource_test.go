@@ -18,13 +18,19 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
 	"testing"
 
 	. "github.com/bytedance/mockey"
 	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -144,6 +150,265 @@ func TestAccResourceGroupResourcePositive(t *testing.T) {
 	})
 }
 
+// UT
+func TestResourceGroupNodeChanges(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	deployment := &scaleiotypes.ServiceResponse{
+		ServiceTemplate: scaleiotypes.TemplateDetails{
+			Components: []scaleiotypes.Components{
+				{ID: "node-1", Type: "SERVER"},
+				{ID: "node-2", Type: "SERVER"},
+				{ID: "node-3", Type: "SERVER"},
+				{ID: "cluster", Type: "SCALEIO"},
+			},
+		},
+		DeploymentDevice: []scaleiotypes.DeploymentDevice{
+			{RefID: "ref-1", ServiceTag: "TAG1", IPAddress: "10.0.0.1", ComponentID: "node-1"},
+			{RefID: "ref-2", ServiceTag: "TAG2", IPAddress: "10.0.0.2", ComponentID: "node-2"},
+			{RefID: "ref-3", ServiceTag: "TAG3", IPAddress: "10.0.0.3", ComponentID: "node-3"},
+			{RefID: "ref-gw", IPAddress: "10.0.0.10", ComponentID: "cluster"},
+		},
+	}
+	replacement := func(node, refID string) types.Object {
+		return types.ObjectValueMust(helper.ResourceGroupNodeReplacementAttrTypes, map[string]attr.Value{
+			"node":   types.StringValue(node),
+			"ref_id": types.StringValue(refID),
+		})
+	}
+	plan := models.ResourceGroupResourceModel{
+		RemoveNodes:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tag1"), types.StringValue("10.0.0.9")}),
+		ReplaceNodes: types.ListValueMust(types.ObjectType{AttrTypes: helper.ResourceGroupNodeReplacementAttrTypes}, []attr.Value{replacement("10.0.0.2", "ref-4")}),
+	}
+
+	state := models.ResourceGroupResourceModel{
+		RemoveNodes:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.9")}),
+		ReplaceNodes: types.ListNull(types.ObjectType{AttrTypes: helper.ResourceGroupNodeReplacementAttrTypes}),
+	}
+
+	// remove a node by service tag, ignore a node removed by a previous apply and replace a node by IP
+	changes, diags := helper.GetResourceGroupNodeChanges(context.Background(), deployment, plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(changes.Remove) != 1 || changes.Remove[0] != "node-1" {
+		t.Fatalf("expected node-1 to be removed, got %v", changes.Remove)
+	}
+	if len(changes.Replace) != 1 || changes.Replace["node-2"] != "ref-4" {
+		t.Fatalf("expected node-2 to be replaced with ref-4, got %v", changes.Replace)
+	}
+
+	// the teardown recorded in the state is not requested again when the deployment of the previous apply failed
+	state.Nodes = types.Int64Value(3)
+	recorded := helper.RecordResourceGroupNodeChanges(plan, state, changes)
+	if recorded.Nodes.ValueInt64() != 2 {
+		t.Fatalf("expected 2 nodes recorded, got %d", recorded.Nodes.ValueInt64())
+	}
+	tornDown := &scaleiotypes.ServiceResponse{
+		ServiceTemplate: scaleiotypes.TemplateDetails{
+			Components: append(deployment.ServiceTemplate.Components, scaleiotypes.Components{ID: "node-4", Type: "SERVER"}),
+		},
+		DeploymentDevice: []scaleiotypes.DeploymentDevice{
+			{RefID: "ref-3", ServiceTag: "TAG3", IPAddress: "10.0.0.3", ComponentID: "node-3"},
+			{RefID: "ref-4", ServiceTag: "TAG4", IPAddress: "10.0.0.4", ComponentID: "node-4"},
+		},
+	}
+	if changes, diags := helper.GetResourceGroupNodeChanges(context.Background(), tornDown, plan, recorded); diags.HasError() || changes.HasChanges() {
+		t.Fatalf("expected the recorded teardown to be ignored, got %v %v", changes, diags)
+	}
+
+	// a node which is not part of the resource group and was not removed by a previous apply is rejected
+	if _, diags := helper.GetResourceGroupNodeChanges(context.Background(), deployment, plan, models.ResourceGroupResourceModel{}); !diags.HasError() {
+		t.Fatal("expected error when removing a node which is not part of the resource group")
+	}
+
+	// a replacement of a node which is not part of the resource group is only accepted if it was applied before
+	plan.RemoveNodes = types.SetNull(types.StringType)
+	plan.ReplaceNodes = types.ListValueMust(types.ObjectType{AttrTypes: helper.ResourceGroupNodeReplacementAttrTypes}, []attr.Value{replacement("TAG5", "ref-5")})
+	if _, diags := helper.GetResourceGroupNodeChanges(context.Background(), deployment, plan, state); !diags.HasError() {
+		t.Fatal("expected error when replacing a node which is not part of the resource group")
+	}
+	state.ReplaceNodes = plan.ReplaceNodes
+	if changes, diags := helper.GetResourceGroupNodeChanges(context.Background(), deployment, plan, state); diags.HasError() || changes.HasChanges() {
+		t.Fatalf("expected the applied replacement to be ignored, got %v %v", changes, diags)
+	}
+	plan.RemoveNodes = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("tag1"), types.StringValue("10.0.0.9")})
+
+	// a node cannot be replaced with a node which is already part of the resource group
+	plan.ReplaceNodes = types.ListValueMust(types.ObjectType{AttrTypes: helper.ResourceGroupNodeReplacementAttrTypes}, []attr.Value{replacement("TAG2", "ref-3")})
+	if _, diags := helper.GetResourceGroupNodeChanges(context.Background(), deployment, plan, state); !diags.HasError() {
+		t.Fatal("expected error when replacing with a deployed node")
+	}
+
+	// a node cannot be removed and replaced at the same time
+	plan.ReplaceNodes = types.ListValueMust(types.ObjectType{AttrTypes: helper.ResourceGroupNodeReplacementAttrTypes}, []attr.Value{replacement("TAG1", "ref-4")})
+	if _, diags := helper.GetResourceGroupNodeChanges(context.Background(), deployment, plan, state); !diags.HasError() {
+		t.Fatal("expected error when removing and replacing the same node")
+	}
+}
+
+// UT
+func TestResourceGroupOverrides(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	template := &scaleiotypes.TemplateDetails{
+		TemplateName: "hci-template",
+		Components: []scaleiotypes.Components{
+			{
+				Name: "Node",
+				Resources: []scaleiotypes.Resources{
+					{
+						ID: "asm::server",
+						Parameters: []scaleiotypes.ParametersDetails{
+							{ID: "os_host_name", Type: "STRING", MaxLength: 15},
+							{ID: "scaleio_role", Type: "ENUMERATED", Options: []scaleiotypes.OptionsDetails{{Value: "hci"}, {Value: "storage"}}},
+							{ID: "razor_image", Type: "STRING", ReadOnly: true},
+						},
+					},
+				},
+			},
+			{
+				Name: "PowerFlex Cluster",
+				Resources: []scaleiotypes.Resources{
+					{
+						ID:         "asm::scaleio",
+						Parameters: []scaleiotypes.ParametersDetails{{ID: "number_of_sds", Type: "INTEGER", Min: 3, Max: 128}},
+					},
+				},
+			},
+		},
+	}
+	override := func(component, parameter, value string) models.ResourceGroupOverrideModel {
+		return models.ResourceGroupOverrideModel{
+			Component: types.StringValue(component),
+			Parameter: types.StringValue(parameter),
+			Value:     types.StringValue(value),
+		}
+	}
+
+	// valid overrides
+	diags := helper.ValidateResourceGroupOverrides(template, []models.ResourceGroupOverrideModel{
+		override("Node", "os_host_name", "node-1"),
+		override("Node", "scaleio_role", "storage"),
+		override("PowerFlex Cluster", "number_of_sds", "4"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// each invalid override is reported
+	diags = helper.ValidateResourceGroupOverrides(template, []models.ResourceGroupOverrideModel{
+		override("Switch", "os_host_name", "node-1"),
+		override("Node", "unknown", "value"),
+		override("Node", "razor_image", "image"),
+		override("Node", "os_host_name", "a-very-long-hostname"),
+		override("Node", "scaleio_role", "compute"),
+		override("PowerFlex Cluster", "number_of_sds", "2"),
+	})
+	if diags.ErrorsCount() != 6 {
+		t.Fatalf("expected 6 errors, got %v", diags)
+	}
+}
+
+// UT
+func TestResourceGroupOverridesChanged(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	overrideType := types.ObjectType{AttrTypes: helper.ResourceGroupOverrideAttrTypes}
+	overrides := types.ListValueMust(overrideType, []attr.Value{
+		types.ObjectValueMust(helper.ResourceGroupOverrideAttrTypes, map[string]attr.Value{
+			"component": types.StringValue("Node"),
+			"parameter": types.StringValue("os_host_name"),
+			"value":     types.StringValue("node-1"),
+		}),
+	})
+	changed := func(state, plan types.List) bool {
+		return helper.IsResourceGroupOverridesChanged(models.ResourceGroupResourceModel{Overrides: state}, models.ResourceGroupResourceModel{Overrides: plan})
+	}
+
+	if changed(overrides, overrides) || changed(types.ListNull(overrideType), types.ListValueMust(overrideType, []attr.Value{})) {
+		t.Fatal("expected unchanged overrides")
+	}
+	if changed(overrides, types.ListUnknown(overrideType)) {
+		t.Fatal("expected unknown overrides not to be compared")
+	}
+	// overrides cannot be added after the deployment
+	if !changed(types.ListNull(overrideType), overrides) {
+		t.Fatal("expected added overrides to be a change")
+	}
+	// overrides cannot be removed after the deployment
+	if !changed(overrides, types.ListNull(overrideType)) {
+		t.Fatal("expected removed overrides to be a change")
+	}
+}
+
+// UT
+func TestResourceGroupLifecycle(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	newDeployment := func() map[string]interface{} {
+		return map[string]interface{}{
+			"serviceTemplate": map[string]interface{}{
+				"components": []interface{}{
+					map[string]interface{}{"id": "node-1", "type": "SERVER"},
+					map[string]interface{}{"id": "node-2", "type": "SERVER"},
+					map[string]interface{}{"id": "vm-1", "type": "VIRTUALMACHINE"},
+					map[string]interface{}{"id": "cluster", "type": "SCALEIO"},
+				},
+			},
+		}
+	}
+	teardown := func(deployment map[string]interface{}) []string {
+		ids := make([]string, 0)
+		for _, item := range deployment["serviceTemplate"].(map[string]interface{})["components"].([]interface{}) {
+			component := item.(map[string]interface{})
+			if component["teardown"] == true {
+				ids = append(ids, component["id"].(string))
+			}
+		}
+		return ids
+	}
+
+	// only the nodes are torn down to reset their iDRAC
+	deployment := newDeployment()
+	if count := helper.SetResourceGroupTeardown(deployment, true, false); count != 2 {
+		t.Fatalf("expected 2 components to tear down, got %d", count)
+	}
+	if ids := teardown(deployment); len(ids) != 2 || ids[0] != "node-1" || ids[1] != "node-2" {
+		t.Fatalf("expected the nodes to be torn down, got %v", ids)
+	}
+	if deployment["teardown"] != true || deployment["removeService"] != false {
+		t.Fatalf("expected the deployment to be torn down without removing it, got %v", deployment)
+	}
+
+	// only the VMs are torn down to delete them
+	deployment = newDeployment()
+	helper.SetResourceGroupTeardown(deployment, false, true)
+	if ids := teardown(deployment); len(ids) != 1 || ids[0] != "vm-1" {
+		t.Fatalf("expected only the VM to be torn down, got %v", ids)
+	}
+
+	// nothing is torn down with the default delete behavior
+	deployment = newDeployment()
+	if count := helper.SetResourceGroupTeardown(deployment, false, false); count != 0 || deployment["teardown"] != false {
+		t.Fatalf("expected nothing to be torn down, got %v", teardown(deployment))
+	}
+
+	// only the nodes and the cluster of an adopted resource group are brownfield
+	template := newDeployment()["serviceTemplate"].(map[string]interface{})
+	helper.SetResourceGroupBrownfield(template)
+	for _, item := range template["components"].([]interface{}) {
+		component := item.(map[string]interface{})
+		if brownfield := component["type"] != "VIRTUALMACHINE"; (component["brownfield"] == true) != brownfield {
+			t.Fatalf("expected brownfield to be %t, got %v", brownfield, component)
+		}
+	}
+}
+
 var ResourceGroupResourceConfig1 = `
 resource "powerflex_resource_group" "data" {
 	deployment_name = "Test-Create-Update"
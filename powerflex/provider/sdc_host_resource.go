@@ -95,6 +95,15 @@ func (r *sdcHostResource) ValidateConfig(ctx context.Context, req resource.Valid
 		)
 	}
 
+	// SDC authentication is configured through drv_cfg, which is supported only for linux
+	if !cfg.OS.IsUnknown() && cfg.OS.ValueString() != "linux" && cfg.EnableAuthentication.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enable_authentication"),
+			"SDC authentication can only be configured for linux SDC",
+			"",
+		)
+	}
+
 }
 
 func (r *sdcHostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -245,6 +254,37 @@ func (r *sdcHostResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					},
 				},
 			},
+			"enable_authentication": schema.BoolAttribute{
+				Description: "Configure CHAP authentication of the SDC with the MDM. Supported only for linux SDC." +
+					" When set to `true`, the CHAP secret of the SDC is set on PowerFlex and pushed to the drv_cfg of the host." +
+					" SDC authentication must be enabled on the system using `sdc_authentication_enabled` of `powerflex_system` for it to take effect." +
+					" Cannot be changed back to `false` once set to `true`." +
+					" Defaults to `false`.",
+				MarkdownDescription: "Configure CHAP authentication of the SDC with the MDM. Supported only for linux SDC." +
+					" When set to `true`, the CHAP secret of the SDC is set on PowerFlex and pushed to the drv_cfg of the host." +
+					" SDC authentication must be enabled on the system using `sdc_authentication_enabled` of `powerflex_system` for it to take effect." +
+					" Cannot be changed back to `false` once set to `true`." +
+					" Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"chap_secret": schema.StringAttribute{
+				Description: "CHAP secret of the SDC. Used only when `enable_authentication` is `true`." +
+					" If not provided, a CHAP secret is generated by PowerFlex.",
+				MarkdownDescription: "CHAP secret of the SDC. Used only when `enable_authentication` is `true`." +
+					" If not provided, a CHAP secret is generated by PowerFlex.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("enable_authentication")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"linux_drv_cfg": schema.StringAttribute{
 				Description:         "Path to the drv_cfg for linux, defaults to /opt/emc/scaleio/sdc/bin/",
 				MarkdownDescription: "Path to the drv_cfg for linux, defaults to /opt/emc/scaleio/sdc/bin/",
@@ -376,6 +416,17 @@ func (r *sdcHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 			"Please update the SDC IP by other means and refresh state by running terraform apply -refresh-only",
 		)
 	}
+
+	// the CHAP secret cannot be removed from the drv_cfg of the host, so authentication cannot be disabled once configured
+	if helper.Known(state.EnableAuthentication, plan.EnableAuthentication) &&
+		state.EnableAuthentication.ValueBool() && !plan.EnableAuthentication.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enable_authentication"),
+			"SDC authentication cannot be disabled once configured",
+			"The CHAP secret configured on the SDC cannot be removed through this resource."+
+				" Please disable SDC authentication on the system using `sdc_authentication_enabled` of `powerflex_system` instead.",
+		)
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -429,6 +480,7 @@ func (r *sdcHostResource) Create(ctx context.Context, req resource.CreateRequest
 
 	resHelper := helper.SdcHostResource{
 		System: r.system,
+		Client: r.client,
 	}
 
 	// install software
@@ -479,6 +531,16 @@ func (r *sdcHostResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// configure SDC authentication
+	if plan.EnableAuthentication.ValueBool() {
+		planAuth, diagAuth := resHelper.ConfigureSdcAuthentication(ctx, plan, currState.ID.ValueString())
+		plan = planAuth
+		resp.Diagnostics.Append(diagAuth...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// read final state of SDC and set state
 	state, err := resHelper.ReadSDCHost(ctx, r.client, plan)
 	if err != nil {
@@ -505,6 +567,7 @@ func (r *sdcHostResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	resHelper := helper.SdcHostResource{
 		System: r.system,
+		Client: r.client,
 	}
 
 	newState, err := resHelper.ReadSDCHost(ctx, r.client, state)
@@ -556,6 +619,7 @@ func (r *sdcHostResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	resHelper := helper.SdcHostResource{
 		System: r.system,
+		Client: r.client,
 	}
 
 	// Only run this update if the mdms need to be updated
@@ -583,6 +647,17 @@ func (r *sdcHostResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// configure SDC authentication if it is getting enabled or the CHAP secret is changed
+	if plan.EnableAuthentication.ValueBool() &&
+		(!currState.EnableAuthentication.ValueBool() || !plan.ChapSecret.Equal(currState.ChapSecret)) {
+		planAuth, diagAuth := resHelper.ConfigureSdcAuthentication(ctx, plan, currState.ID.ValueString())
+		plan = planAuth
+		resp.Diagnostics.Append(diagAuth...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// read final state of SDC and set state
 	state, err := resHelper.ReadSDCHost(ctx, r.client, plan)
 	if err != nil {
@@ -624,6 +699,7 @@ func (r *sdcHostResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	resHelper := helper.SdcHostResource{
 		System: r.system,
+		Client: r.client,
 	}
 
 	// remove software
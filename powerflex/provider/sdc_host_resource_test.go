@@ -93,6 +93,36 @@ resource powerflex_sdc_host sdc {
 }
 `
 
+var windowsSdcAuthenticationUt = `
+resource powerflex_sdc_host sdc {
+	ip = "1.1.1.1"
+	os_family = "windows"
+	remote = {
+		port = "123"
+		user = "user"
+		password = "pass"
+	}
+	name = "sdc-windows"
+	package_path = "/tmp/tfaccsdc1.tar"
+	enable_authentication = true
+}
+`
+
+var linuxSdcChapSecretUt = `
+resource powerflex_sdc_host sdc {
+	ip = "1.1.1.1"
+	os_family = "linux"
+	remote = {
+		port = "123"
+		user = "user"
+		password = "pass"
+	}
+	name = "sdc-linux"
+	package_path = "/tmp/tfaccsdc1.tar"
+	chap_secret = "secret"
+}
+`
+
 var osUpdateErrorUt = `
 resource powerflex_sdc_host sdc {
 	ip = "1.1.1.1"
@@ -131,21 +161,23 @@ var valFake, _ = types.ObjectValue(
 var listVal, _ = types.ListValueFrom(context.TODO(), types.StringType, []string{"1.1.1.1", "1.1.1.2"})
 
 var sdcWindowsFakeModel = models.SdcHostModel{
-	Remote:             valFake,
-	MdmIPs:             types.ListNull(types.StringType),
-	ID:                 types.StringValue("1.1.1.1"),
-	Host:               types.StringValue("1.1.1.1"),
-	OS:                 types.StringValue("windows"),
-	LinuxDrvCfg:        types.StringValue("/opt/emc/scaleio/sdc/bin/"),
-	WindowsDrvCfg:      types.StringValue("C:\\Program Files\\EMC\\scaleio\\sdc\\bin\\"),
-	UseRemotePath:      types.BoolValue(false),
-	Name:               types.StringValue("sdc-windows"),
-	Pkg:                types.StringValue("/tmp/tfaccsdc1.tar"),
-	PerformanceProfile: types.StringValue("default"),
-	MdmConnectionState: types.StringValue("connected"),
-	OnVMWare:           types.BoolValue(false),
-	GUID:               types.StringValue("1234"),
-	IsApproved:         types.BoolValue(true),
+	Remote:               valFake,
+	MdmIPs:               types.ListNull(types.StringType),
+	ID:                   types.StringValue("1.1.1.1"),
+	Host:                 types.StringValue("1.1.1.1"),
+	OS:                   types.StringValue("windows"),
+	LinuxDrvCfg:          types.StringValue("/opt/emc/scaleio/sdc/bin/"),
+	WindowsDrvCfg:        types.StringValue("C:\\Program Files\\EMC\\scaleio\\sdc\\bin\\"),
+	UseRemotePath:        types.BoolValue(false),
+	EnableAuthentication: types.BoolValue(false),
+	Name:                 types.StringValue("sdc-windows"),
+	Pkg:                  types.StringValue("/tmp/tfaccsdc1.tar"),
+	PerformanceProfile:   types.StringValue("default"),
+	MdmConnectionState:   types.StringValue("connected"),
+	OnVMWare:             types.BoolValue(false),
+	GUID:                 types.StringValue("1234"),
+	IsApproved:           types.BoolValue(true),
+	ChapSecret:           types.StringNull(),
 	Esxi: types.ObjectNull(map[string]attr.Type{
 		"guid":                 types.StringType,
 		"verify_vib_signature": types.BoolType,
@@ -153,21 +185,23 @@ var sdcWindowsFakeModel = models.SdcHostModel{
 }
 
 var sdcWindowsFakeUpdateModel = models.SdcHostModel{
-	Remote:             valFake,
-	MdmIPs:             listVal,
-	ID:                 types.StringValue("1.1.1.1"),
-	Host:               types.StringValue("1.1.1.1"),
-	OS:                 types.StringValue("windows"),
-	LinuxDrvCfg:        types.StringValue("/opt/emc/scaleio/sdc/bin/"),
-	WindowsDrvCfg:      types.StringValue("C:\\Program Files\\EMC\\scaleio\\sdc\\bin\\"),
-	UseRemotePath:      types.BoolValue(false),
-	Name:               types.StringValue("sdc-windows-update"),
-	Pkg:                types.StringValue("/tmp/tfaccsdc1.tar"),
-	PerformanceProfile: types.StringValue("default"),
-	MdmConnectionState: types.StringValue("connected"),
-	OnVMWare:           types.BoolValue(false),
-	GUID:               types.StringValue("1234"),
-	IsApproved:         types.BoolValue(true),
+	Remote:               valFake,
+	MdmIPs:               listVal,
+	ID:                   types.StringValue("1.1.1.1"),
+	Host:                 types.StringValue("1.1.1.1"),
+	OS:                   types.StringValue("windows"),
+	LinuxDrvCfg:          types.StringValue("/opt/emc/scaleio/sdc/bin/"),
+	WindowsDrvCfg:        types.StringValue("C:\\Program Files\\EMC\\scaleio\\sdc\\bin\\"),
+	UseRemotePath:        types.BoolValue(false),
+	EnableAuthentication: types.BoolValue(false),
+	Name:                 types.StringValue("sdc-windows-update"),
+	Pkg:                  types.StringValue("/tmp/tfaccsdc1.tar"),
+	PerformanceProfile:   types.StringValue("default"),
+	MdmConnectionState:   types.StringValue("connected"),
+	OnVMWare:             types.BoolValue(false),
+	GUID:                 types.StringValue("1234"),
+	IsApproved:           types.BoolValue(true),
+	ChapSecret:           types.StringNull(),
 	Esxi: types.ObjectNull(map[string]attr.Type{
 		"guid":                 types.StringType,
 		"verify_vib_signature": types.BoolType,
@@ -210,6 +244,16 @@ func TestAccResourceSDCHostUT(t *testing.T) {
 				Config:      ProviderConfigForTesting + windowsSdcNoRemoteUt,
 				ExpectError: regexp.MustCompile(`.*Password is required for Windows SDC*`),
 			},
+			// 3.1 SDC authentication on windows Error
+			{
+				Config:      ProviderConfigForTesting + windowsSdcAuthenticationUt,
+				ExpectError: regexp.MustCompile(`.*SDC authentication can only be configured for linux SDC*`),
+			},
+			// 3.2 CHAP secret without SDC authentication Error
+			{
+				Config:      ProviderConfigForTesting + linuxSdcChapSecretUt,
+				ExpectError: regexp.MustCompile(`.*Attribute "enable_authentication" must be specified*`),
+			},
 			// 4 Read Error
 			{
 				PreConfig: func() {
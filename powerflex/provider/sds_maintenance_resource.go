@@ -0,0 +1,245 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"time"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &sdsMaintenanceResource{}
+	_ resource.ResourceWithConfigure = &sdsMaintenanceResource{}
+)
+
+// NewSdsMaintenanceResource - function to return resource interface
+func NewSdsMaintenanceResource() resource.Resource {
+	return &sdsMaintenanceResource{}
+}
+
+// sdsMaintenanceResource - struct to define SDS maintenance resource
+type sdsMaintenanceResource struct {
+	client *goscaleio.Client
+	system *goscaleio.System
+}
+
+// Metadata - function to return metadata for SDS maintenance resource.
+func (r *sdsMaintenanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sds_maintenance"
+}
+
+// Schema - function to return Schema for SDS maintenance resource.
+func (r *sdsMaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SdsMaintenanceResourceSchema
+}
+
+// Configure - function to return Configuration for SDS maintenance resource.
+func (r *sdsMaintenanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+
+	// Get the system on the PowerFlex cluster
+	system, err := helper.GetFirstSystem(r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting system instance on the PowerFlex cluster",
+			err.Error(),
+		)
+		return
+	}
+	r.system = system
+}
+
+// Create - function to put the SDS into maintenance mode.
+func (r *sdsMaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.SdsMaintenanceResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sds, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, helper.UpdateSdsMaintenanceState(plan, sds))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read - function to read the maintenance mode of the SDS.
+func (r *sdsMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.SdsMaintenanceResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sds, err := r.system.GetSdsByID(state.SdsID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting SDS details",
+			err.Error(),
+		)
+		return
+	}
+	diags = resp.State.Set(ctx, helper.UpdateSdsMaintenanceState(state, &sds))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to enter or exit the maintenance mode of the SDS.
+func (r *sdsMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan models.SdsMaintenanceResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sds, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, helper.UpdateSdsMaintenanceState(plan, sds))
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete - function to take the SDS out of maintenance mode.
+func (r *sdsMaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	var state models.SdsMaintenanceResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Enabled = types.BoolValue(false)
+	_, diags = r.apply(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// apply enters or exits the maintenance mode of the SDS as planned and returns the resulting SDS
+func (r *sdsMaintenanceResource) apply(ctx context.Context, plan models.SdsMaintenanceResourceModel) (*scaleiotypes.Sds, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id := plan.SdsID.ValueString()
+	deadline := time.Now().Add(time.Duration(plan.Timeout.ValueInt64()) * time.Minute)
+
+	sds, err := r.system.GetSdsByID(id)
+	if err != nil {
+		diags.AddError(
+			"Error in getting SDS details",
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	// an SDS in the other type of maintenance mode exits it before entering the planned one
+	inMaintenance := helper.IsSdsInMaintenance(sds)
+	if inMaintenance && plan.Enabled.ValueBool() && helper.GetSdsMaintenanceType(sds) == plan.MaintenanceType.ValueString() {
+		updated, err := helper.WaitForSdsMaintenanceState(ctx, r.system, id, helper.SdsInMaintenance, time.Until(deadline))
+		if err != nil {
+			diags.AddError("Error entering maintenance mode", err.Error())
+		}
+		return updated, diags
+	}
+	if inMaintenance || sds.MaintenanceState == helper.SdsExitMaintenanceProgress {
+		if inMaintenance {
+			tflog.Info(ctx, "Exiting maintenance mode", map[string]interface{}{"id": id})
+			if err := helper.ExitSdsMaintenanceMode(r.client, sds); err != nil {
+				diags.AddError("Error exiting maintenance mode", err.Error())
+				return nil, diags
+			}
+		}
+		updated, err := helper.WaitForSdsMaintenanceState(ctx, r.system, id, helper.SdsNoMaintenance, time.Until(deadline))
+		if err != nil {
+			diags.AddError("Error exiting maintenance mode", err.Error())
+			return nil, diags
+		}
+		sds = *updated
+	}
+	if !plan.Enabled.ValueBool() {
+		return &sds, diags
+	}
+
+	allSds, err := r.system.GetAllSds()
+	if err != nil {
+		diags.AddError(
+			"Error in getting SDS details",
+			err.Error(),
+		)
+		return nil, diags
+	}
+	if conflict := helper.GetSdsMaintenanceConflict(sds, allSds); conflict != nil {
+		diags.AddError(
+			"Error entering maintenance mode",
+			fmt.Sprintf("SDS %s of the same fault set is already in maintenance mode, please take it out of maintenance mode first", conflict.Name),
+		)
+		return nil, diags
+	}
+
+	// the data is expected to be fully protected before an SDS goes down
+	if err := helper.WaitForRebuildRebalance(ctx, r.client, time.Until(deadline)); err != nil {
+		diags.AddError("Error entering maintenance mode", err.Error())
+		return nil, diags
+	}
+
+	tflog.Info(ctx, "Entering maintenance mode", map[string]interface{}{"id": id, "type": plan.MaintenanceType.ValueString()})
+	if err := helper.EnterSdsMaintenanceMode(r.client, id, plan.MaintenanceType.ValueString()); err != nil {
+		diags.AddError("Error entering maintenance mode", err.Error())
+		return nil, diags
+	}
+	updated, err := helper.WaitForSdsMaintenanceState(ctx, r.system, id, helper.SdsInMaintenance, time.Until(deadline))
+	if err != nil {
+		diags.AddError("Error entering maintenance mode", err.Error())
+		return nil, diags
+	}
+	return updated, diags
+}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SdsMaintenanceResourceSchema defines the schema for SDS maintenance resource
+var SdsMaintenanceResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to put an SDS into instant or protected maintenance mode, for example for hardware work on its node." +
+		" The maintenance mode is entered only once there is no rebuild or rebalance in progress, and is refused when another SDS of the same fault set is already in maintenance mode." +
+		" The SDS exits maintenance mode when enabled is set to false or when the resource is destroyed." +
+		" This resource supports Create, Update and Delete operations.",
+	MarkdownDescription: "This resource is used to put an SDS into instant or protected maintenance mode, for example for hardware work on its node." +
+		" The maintenance mode is entered only once there is no rebuild or rebalance in progress, and is refused when another SDS of the same fault set is already in maintenance mode." +
+		" The SDS exits maintenance mode when `enabled` is set to `false` or when the resource is destroyed." +
+		" This resource supports Create, Update and Delete operations.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the SDS maintenance, which is the ID of the SDS.",
+			MarkdownDescription: "ID of the SDS maintenance, which is the ID of the SDS.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sds_id": schema.StringAttribute{
+			Description:         "ID of the SDS.",
+			MarkdownDescription: "ID of the SDS.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"maintenance_type": schema.StringAttribute{
+			Description: "Type of the maintenance mode. Accepted values are Instant and Protected. Default value is Instant." +
+				" In protected maintenance mode, the data of the SDS is copied to the other SDSs before the SDS enters maintenance mode.",
+			MarkdownDescription: "Type of the maintenance mode. Accepted values are `Instant` and `Protected`. Default value is `Instant`." +
+				" In protected maintenance mode, the data of the SDS is copied to the other SDSs before the SDS enters maintenance mode.",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString(helper.SdsMaintenanceInstant),
+			Validators: []validator.String{
+				stringvalidator.OneOf(helper.SdsMaintenanceInstant, helper.SdsMaintenanceProtected),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Description:         "Whether the SDS is in maintenance mode. Default value is `true`.",
+			MarkdownDescription: "Whether the SDS is in maintenance mode. Default value is `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"timeout": schema.Int64Attribute{
+			Description:         "Describes the time in minutes to timeout entering or exiting the maintenance mode.",
+			MarkdownDescription: "Describes the time in minutes to timeout entering or exiting the maintenance mode.",
+			Optional:            true,
+			Computed:            true,
+			Default:             int64default.StaticInt64(60),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"maintenance_state": schema.StringAttribute{
+			Description:         "Maintenance state of the SDS.",
+			MarkdownDescription: "Maintenance state of the SDS.",
+			Computed:            true,
+		},
+		"fault_set_id": schema.StringAttribute{
+			Description:         "ID of the fault set of the SDS.",
+			MarkdownDescription: "ID of the fault set of the SDS.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"protection_domain_id": schema.StringAttribute{
+			Description:         "ID of the protection domain of the SDS.",
+			MarkdownDescription: "ID of the protection domain of the SDS.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var sdsMaintenanceConfig = `
+resource "powerflex_sds_maintenance" "test" {
+	sds_id = "0db7306f00000003"
+}
+`
+
+var sdsMaintenanceMock = scaleiotypes.Sds{
+	ID:                 "0db7306f00000003",
+	Name:               "SDS_1",
+	ProtectionDomainID: "4eeb304600000000",
+	FaultSetID:         "e8b6a8b600000000",
+	MaintenanceState:   helper.SdsNoMaintenance,
+	MaintenanceType:    helper.SdsNoMaintenance,
+}
+
+var sdsMaintenanceOtherMock = scaleiotypes.Sds{
+	ID:                 "0db7306f00000004",
+	Name:               "SDS_2",
+	ProtectionDomainID: "4eeb304600000000",
+	FaultSetID:         "e8b6a8b600000000",
+	MaintenanceState:   helper.SdsInMaintenance,
+	MaintenanceType:    "SdsMaintenance",
+}
+
+// UT
+func TestAccResourceSdsMaintenanceUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	inMaintenance := sdsMaintenanceMock
+	inMaintenance.MaintenanceState = helper.SdsInMaintenance
+	inMaintenance.MaintenanceType = "SdsMaintenance"
+	var allSdsMocker, waitMocker, exitMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Another SDS of the same fault set is in maintenance mode
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*goscaleio.System).GetSdsByID).Return(sdsMaintenanceMock, nil).Build()
+					allSdsMocker = Mock((*goscaleio.System).GetAllSds).Return([]scaleiotypes.Sds{sdsMaintenanceMock, sdsMaintenanceOtherMock}, nil).Build()
+				},
+				Config:      ProviderConfigForTesting + sdsMaintenanceConfig,
+				ExpectError: regexp.MustCompile(`.*SDS SDS_2 of the same fault set is already in maintenance mode*.`),
+			},
+			// The SDS is already in instant maintenance mode
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock((*goscaleio.System).GetSdsByID).Return(inMaintenance, nil).Build()
+					waitMocker = Mock(helper.WaitForSdsMaintenanceState).Return(&inMaintenance, nil).Build()
+					exitMocker = Mock(helper.ExitSdsMaintenanceMode).Return(nil).Build()
+				},
+				Config: ProviderConfigForTesting + sdsMaintenanceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_sds_maintenance.test", "id", sdsMaintenanceMock.ID),
+					resource.TestCheckResourceAttr("powerflex_sds_maintenance.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("powerflex_sds_maintenance.test", "maintenance_type", "Instant"),
+					resource.TestCheckResourceAttr("powerflex_sds_maintenance.test", "maintenance_state", helper.SdsInMaintenance),
+					resource.TestCheckResourceAttr("powerflex_sds_maintenance.test", "fault_set_id", sdsMaintenanceMock.FaultSetID),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if allSdsMocker != nil {
+				allSdsMocker.UnPatch()
+			}
+			if waitMocker != nil {
+				waitMocker.UnPatch()
+			}
+			if exitMocker != nil {
+				exitMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestSdsMaintenanceConflict(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+
+	// an SDS of the same fault set in maintenance mode is a conflict
+	conflict := helper.GetSdsMaintenanceConflict(sdsMaintenanceMock, []scaleiotypes.Sds{sdsMaintenanceMock, sdsMaintenanceOtherMock})
+	if conflict == nil || conflict.ID != sdsMaintenanceOtherMock.ID {
+		t.Fatalf("expected SDS %s to conflict, got %v", sdsMaintenanceOtherMock.ID, conflict)
+	}
+
+	// an SDS of another fault set is not a conflict
+	other := sdsMaintenanceOtherMock
+	other.FaultSetID = "e8b6a8b600000001"
+	if conflict := helper.GetSdsMaintenanceConflict(sdsMaintenanceMock, []scaleiotypes.Sds{sdsMaintenanceMock, other}); conflict != nil {
+		t.Fatalf("expected no conflict, got %v", conflict)
+	}
+
+	// the SDS itself is not a conflict
+	if conflict := helper.GetSdsMaintenanceConflict(sdsMaintenanceOtherMock, []scaleiotypes.Sds{sdsMaintenanceOtherMock}); conflict != nil {
+		t.Fatalf("expected no conflict, got %v", conflict)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &supportAssistDataSource{}
+	_ datasource.DataSourceWithConfigure = &supportAssistDataSource{}
+)
+
+// SupportAssistDataSource returns the SupportAssist data source
+func SupportAssistDataSource() datasource.DataSource {
+	return &supportAssistDataSource{}
+}
+
+type supportAssistDataSource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+func (d *supportAssistDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_support_assist"
+}
+
+func (d *supportAssistDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = SupportAssistDataSourceSchema
+}
+
+func (d *supportAssistDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	d.client = req.ProviderData.(*powerflexProvider).client
+	d.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+func (d *supportAssistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	config, err := helper.GetSupportAssistConfiguration(d.gatewayClient, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting SupportAssist configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	status, err := helper.GetSupportAssistStatus(d.gatewayClient, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting SupportAssist status",
+			err.Error(),
+		)
+		return
+	}
+
+	state := helper.MapSupportAssistDataSourceState(config, status)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// SupportAssistDataSourceSchema defines the schema for SupportAssist datasource
+var SupportAssistDataSourceSchema schema.Schema = schema.Schema{
+	Description:         "This datasource is used to read the SupportAssist (call-home) configuration of PowerFlex Manager along with its registration and last connection status. This feature is only supported for PowerFlex 4.5 and above.",
+	MarkdownDescription: "This datasource is used to read the SupportAssist (call-home) configuration of PowerFlex Manager along with its registration and last connection status. This feature is only supported for PowerFlex 4.5 and above.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the SupportAssist configuration.",
+			MarkdownDescription: "ID of the SupportAssist configuration.",
+			Computed:            true,
+		},
+		"enabled": schema.BoolAttribute{
+			Description:         "Whether SupportAssist is enabled.",
+			MarkdownDescription: "Whether SupportAssist is enabled.",
+			Computed:            true,
+		},
+		"registered": schema.BoolAttribute{
+			Description:         "Whether SupportAssist is registered with the Dell backend.",
+			MarkdownDescription: "Whether SupportAssist is registered with the Dell backend.",
+			Computed:            true,
+		},
+		"connection_type": schema.StringAttribute{
+			Description:         "Type of connection to the Dell backend.",
+			MarkdownDescription: "Type of connection to the Dell backend.",
+			Computed:            true,
+		},
+		"gateway_host": schema.StringAttribute{
+			Description:         "Hostname or IP address of the Secure Connect Gateway.",
+			MarkdownDescription: "Hostname or IP address of the Secure Connect Gateway.",
+			Computed:            true,
+		},
+		"gateway_port": schema.Int64Attribute{
+			Description:         "Port of the Secure Connect Gateway.",
+			MarkdownDescription: "Port of the Secure Connect Gateway.",
+			Computed:            true,
+		},
+		"contact_email": schema.StringAttribute{
+			Description:         "Email address of the contact used by Dell support.",
+			MarkdownDescription: "Email address of the contact used by Dell support.",
+			Computed:            true,
+		},
+		"proxy_host": schema.StringAttribute{
+			Description:         "Hostname or IP address of the proxy server.",
+			MarkdownDescription: "Hostname or IP address of the proxy server.",
+			Computed:            true,
+		},
+		"connection_status": schema.StringAttribute{
+			Description:         "Status of the connection with the Dell backend.",
+			MarkdownDescription: "Status of the connection with the Dell backend.",
+			Computed:            true,
+		},
+		"last_connection_time": schema.StringAttribute{
+			Description:         "Time of the last successful connection with the Dell backend.",
+			MarkdownDescription: "Time of the last successful connection with the Dell backend.",
+			Computed:            true,
+		},
+	},
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Can be used for UT and AT
+func TestAccDatasourceSupportAssist(t *testing.T) {
+	var getStatusMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Successful read
+			{
+				Config: ProviderConfigForTesting + supportAssistData,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerflex_support_assist.default", "enabled"),
+					resource.TestCheckResourceAttrSet("data.powerflex_support_assist.default", "registered"),
+				),
+			},
+			// Error reading the SupportAssist configuration
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetSupportAssistConfiguration).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + supportAssistData,
+				ExpectError: regexp.MustCompile(`.*Error in getting SupportAssist configuration*.`),
+			},
+			// Error reading the SupportAssist status
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetSupportAssistConfiguration).Return(&helper.SupportAssistConfiguration{}, nil).Build()
+					getStatusMocker = Mock(helper.GetSupportAssistStatus).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + supportAssistData,
+				ExpectError: regexp.MustCompile(`.*Error in getting SupportAssist status*.`),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if getStatusMocker != nil {
+				getStatusMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+var supportAssistData = `
+data "powerflex_support_assist" "default" {
+}
+`
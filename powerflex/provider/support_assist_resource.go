@@ -0,0 +1,261 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &supportAssistResource{}
+	_ resource.ResourceWithConfigure      = &supportAssistResource{}
+	_ resource.ResourceWithImportState    = &supportAssistResource{}
+	_ resource.ResourceWithValidateConfig = &supportAssistResource{}
+)
+
+// NewSupportAssistResource - function to return resource interface
+func NewSupportAssistResource() resource.Resource {
+	return &supportAssistResource{}
+}
+
+// supportAssistResource - struct to define SupportAssist resource
+type supportAssistResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for SupportAssist resource.
+func (r *supportAssistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_support_assist"
+}
+
+// Schema - function to return Schema for SupportAssist resource.
+func (r *supportAssistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = SupportAssistResourceSchema
+}
+
+// Configure - function to return Configuration for SupportAssist resource.
+func (r *supportAssistResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// ValidateConfig - function to validate the connection details of SupportAssist resource.
+func (r *supportAssistResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.SupportAssistResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ConnectionType.IsUnknown() {
+		return
+	}
+
+	if config.ConnectionType.ValueString() == "Direct" {
+		if config.AccessKey.IsNull() || config.Pin.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("access_key"),
+				"access_key and pin are required when connection_type is Direct",
+				"",
+			)
+		}
+	}
+
+	if config.ConnectionType.ValueString() == "Gateway" && config.GatewayHost.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("gateway_host"),
+			"gateway_host is required when connection_type is Gateway",
+			"",
+		)
+	}
+}
+
+// Create - function to configure SupportAssist.
+func (r *supportAssistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.SupportAssistResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.configure(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, _, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	// the configuration is applied and tracked in the state even if the connectivity test fails
+	resp.Diagnostics.Append(r.testConnection(ctx, plan)...)
+}
+
+// Read - function to read the SupportAssist configuration.
+func (r *supportAssistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.SupportAssistResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, enabled, diags := r.read(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// SupportAssist was disabled outside of terraform
+	if !enabled {
+		tflog.Info(ctx, "SupportAssist is not enabled, removing it from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to update the SupportAssist configuration.
+func (r *supportAssistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan models.SupportAssistResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.configure(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, _, diags := r.read(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+
+	// the configuration is applied and tracked in the state even if the connectivity test fails
+	resp.Diagnostics.Append(r.testConnection(ctx, plan)...)
+}
+
+// Delete - function to disable SupportAssist.
+func (r *supportAssistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	err := helper.DisableSupportAssist(r.gatewayClient, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error disabling SupportAssist",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState - function to import the SupportAssist configuration.
+func (r *supportAssistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// configure applies the SupportAssist configuration
+func (r *supportAssistResource) configure(ctx context.Context, plan models.SupportAssistResourceModel) (diags diag.Diagnostics) {
+	payload, dgs := helper.GetSupportAssistPayload(ctx, plan)
+	diags.Append(dgs...)
+	if diags.HasError() {
+		return
+	}
+
+	err := helper.SetSupportAssistConfiguration(r.gatewayClient, r.client, payload)
+	if err != nil {
+		diags.AddError(
+			"Error configuring SupportAssist",
+			err.Error(),
+		)
+	}
+	return
+}
+
+// testConnection tests the connectivity with the Dell backend if requested
+func (r *supportAssistResource) testConnection(ctx context.Context, plan models.SupportAssistResourceModel) (diags diag.Diagnostics) {
+	if !plan.TestConnection.ValueBool() {
+		return
+	}
+	tflog.Info(ctx, "Testing SupportAssist connectivity")
+	err := helper.TestSupportAssistConnection(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error testing SupportAssist connectivity",
+			"SupportAssist is configured but the connectivity test failed: "+err.Error(),
+		)
+	}
+	return
+}
+
+// read gets the SupportAssist configuration and status and maps them to the state, it also returns whether SupportAssist is enabled
+func (r *supportAssistResource) read(ctx context.Context, plan models.SupportAssistResourceModel) (models.SupportAssistResourceModel, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config, err := helper.GetSupportAssistConfiguration(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error in getting SupportAssist configuration",
+			err.Error(),
+		)
+		return plan, false, diags
+	}
+
+	status, err := helper.GetSupportAssistStatus(r.gatewayClient, r.client)
+	if err != nil {
+		diags.AddError(
+			"Error in getting SupportAssist status",
+			err.Error(),
+		)
+		return plan, false, diags
+	}
+
+	state, diags := helper.UpdateSupportAssistState(ctx, plan, config, status)
+	return state, config.Enabled, diags
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// SupportAssistResourceSchema defines the schema for SupportAssist resource
+var SupportAssistResourceSchema schema.Schema = schema.Schema{
+	Description:         "This resource is used to configure SupportAssist (call-home) on PowerFlex Manager. This resource supports Create, Update, Delete and Import operations. This feature is only supported for PowerFlex 4.5 and above.",
+	MarkdownDescription: "This resource is used to configure SupportAssist (call-home) on PowerFlex Manager. This resource supports Create, Update, Delete and Import operations. This feature is only supported for PowerFlex 4.5 and above.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the SupportAssist configuration.",
+			MarkdownDescription: "ID of the SupportAssist configuration.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"connection_type": schema.StringAttribute{
+			Description:         "Type of connection to the Dell backend. Accepted values are `Direct` and `Gateway`.",
+			MarkdownDescription: "Type of connection to the Dell backend. Accepted values are `Direct` and `Gateway`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("Direct", "Gateway"),
+			},
+		},
+		"access_key": schema.StringAttribute{
+			Description:         "Access key used to register SupportAssist with the Dell backend. Required when `connection_type` is `Direct`.",
+			MarkdownDescription: "Access key used to register SupportAssist with the Dell backend. Required when `connection_type` is `Direct`.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"pin": schema.StringAttribute{
+			Description:         "PIN associated with the access key. Required when `connection_type` is `Direct`.",
+			MarkdownDescription: "PIN associated with the access key. Required when `connection_type` is `Direct`.",
+			Optional:            true,
+			Sensitive:           true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"gateway_host": schema.StringAttribute{
+			Description:         "Hostname or IP address of the Secure Connect Gateway. Required when `connection_type` is `Gateway`.",
+			MarkdownDescription: "Hostname or IP address of the Secure Connect Gateway. Required when `connection_type` is `Gateway`.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"gateway_port": schema.Int64Attribute{
+			Description:         "Port of the Secure Connect Gateway.",
+			MarkdownDescription: "Port of the Secure Connect Gateway.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"contact": schema.SingleNestedAttribute{
+			Description:         "Contact details used by Dell support.",
+			MarkdownDescription: "Contact details used by Dell support.",
+			Required:            true,
+			Attributes: map[string]schema.Attribute{
+				"first_name": schema.StringAttribute{
+					Description:         "First name of the contact.",
+					MarkdownDescription: "First name of the contact.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"last_name": schema.StringAttribute{
+					Description:         "Last name of the contact.",
+					MarkdownDescription: "Last name of the contact.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"email": schema.StringAttribute{
+					Description:         "Email address of the contact.",
+					MarkdownDescription: "Email address of the contact.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"phone": schema.StringAttribute{
+					Description:         "Phone number of the contact.",
+					MarkdownDescription: "Phone number of the contact.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+		},
+		"proxy": schema.SingleNestedAttribute{
+			Description:         "Proxy server used to reach the Dell backend.",
+			MarkdownDescription: "Proxy server used to reach the Dell backend.",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					Description:         "Hostname or IP address of the proxy server.",
+					MarkdownDescription: "Hostname or IP address of the proxy server.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"port": schema.Int64Attribute{
+					Description:         "Port of the proxy server.",
+					MarkdownDescription: "Port of the proxy server.",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65535),
+					},
+				},
+				"username": schema.StringAttribute{
+					Description:         "Username of the proxy server.",
+					MarkdownDescription: "Username of the proxy server.",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"password": schema.StringAttribute{
+					Description:         "Password of the proxy server.",
+					MarkdownDescription: "Password of the proxy server.",
+					Optional:            true,
+					Sensitive:           true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+			},
+		},
+		"test_connection": schema.BoolAttribute{
+			Description:         "Test the connectivity with the Dell backend after the configuration is applied. The operation fails if the test fails, the applied configuration is still saved in the state. Defaults to `true`.",
+			MarkdownDescription: "Test the connectivity with the Dell backend after the configuration is applied. The operation fails if the test fails, the applied configuration is still saved in the state. Defaults to `true`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(true),
+		},
+		"registered": schema.BoolAttribute{
+			Description:         "Whether SupportAssist is registered with the Dell backend.",
+			MarkdownDescription: "Whether SupportAssist is registered with the Dell backend.",
+			Computed:            true,
+		},
+		"connection_status": schema.StringAttribute{
+			Description:         "Status of the connection with the Dell backend.",
+			MarkdownDescription: "Status of the connection with the Dell backend.",
+			Computed:            true,
+		},
+	},
+}
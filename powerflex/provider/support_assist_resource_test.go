@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var supportAssistGatewayConfig = `
+resource "powerflex_support_assist" "test" {
+	connection_type = "Gateway"
+	gateway_host = "10.10.10.10"
+	gateway_port = 9443
+	contact = {
+		first_name = "Terraform"
+		last_name = "User"
+		email = "terraform@example.com"
+	}
+	test_connection = false
+}
+`
+
+var supportAssistGatewayTestConfig = `
+resource "powerflex_support_assist" "test" {
+	connection_type = "Gateway"
+	gateway_host = "10.10.10.10"
+	gateway_port = 9443
+	contact = {
+		first_name = "Terraform"
+		last_name = "User"
+		email = "terraform@example.com"
+	}
+	test_connection = true
+}
+`
+
+var supportAssistDirectNoKeyConfig = `
+resource "powerflex_support_assist" "test" {
+	connection_type = "Direct"
+	contact = {
+		first_name = "Terraform"
+		last_name = "User"
+		email = "terraform@example.com"
+	}
+}
+`
+
+var supportAssistGatewayNoHostConfig = `
+resource "powerflex_support_assist" "test" {
+	connection_type = "Gateway"
+	contact = {
+		first_name = "Terraform"
+		last_name = "User"
+		email = "terraform@example.com"
+	}
+}
+`
+
+var supportAssistMockConfig = &helper.SupportAssistConfiguration{
+	ID:             "support_assist",
+	Enabled:        true,
+	ConnectionType: "Gateway",
+	GatewayHost:    "10.10.10.10",
+	GatewayPort:    9443,
+	Contact: helper.SupportAssistContact{
+		FirstName: "Terraform",
+		LastName:  "User",
+		Email:     "terraform@example.com",
+	},
+}
+
+// AT
+func TestAccResourceSupportAssist(t *testing.T) {
+	if os.Getenv("TF_ACC") != "1" {
+		t.Skip("Dont run with units tests, this is an Acceptance test")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Configure SupportAssist
+			{
+				Config: ProviderConfigForTesting + supportAssistGatewayConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_support_assist.test", "connection_type", "Gateway"),
+					resource.TestCheckResourceAttr("powerflex_support_assist.test", "contact.email", "terraform@example.com"),
+				),
+			},
+			// Import
+			{
+				ResourceName:            "powerflex_support_assist.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_connection"},
+			},
+		},
+	})
+}
+
+// UT
+func TestAccResourceSupportAssistUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var getConfigMocker, getStatusMocker, disableMocker, testMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Access key and pin are required for Direct connection
+			{
+				Config:      ProviderConfigForTesting + supportAssistDirectNoKeyConfig,
+				ExpectError: regexp.MustCompile(`.*access_key and pin are required when connection_type is Direct*.`),
+			},
+			// Gateway host is required for Gateway connection
+			{
+				Config:      ProviderConfigForTesting + supportAssistGatewayNoHostConfig,
+				ExpectError: regexp.MustCompile(`.*gateway_host is required when connection_type is Gateway*.`),
+			},
+			// Error configuring SupportAssist
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetSupportAssistConfiguration).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + supportAssistGatewayConfig,
+				ExpectError: regexp.MustCompile(`.*Error configuring SupportAssist*.`),
+			},
+			// Error reading SupportAssist status
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetSupportAssistConfiguration).Return(nil).Build()
+					getConfigMocker = Mock(helper.GetSupportAssistConfiguration).Return(supportAssistMockConfig, nil).Build()
+					getStatusMocker = Mock(helper.GetSupportAssistStatus).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + supportAssistGatewayConfig,
+				ExpectError: regexp.MustCompile(`.*Error in getting SupportAssist status*.`),
+			},
+			// Connectivity test fails after the configuration is applied, the configuration is still saved in the state
+			{
+				PreConfig: func() {
+					if getStatusMocker != nil {
+						getStatusMocker.UnPatch()
+					}
+					getStatusMocker = Mock(helper.GetSupportAssistStatus).Return(&helper.SupportAssistStatus{
+						Registered:       true,
+						ConnectionStatus: "Connected",
+					}, nil).Build()
+					disableMocker = Mock(helper.DisableSupportAssist).Return(nil).Build()
+					testMocker = Mock(helper.TestSupportAssistConnection).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + supportAssistGatewayTestConfig,
+				ExpectError: regexp.MustCompile(`.*Error testing SupportAssist connectivity*.`),
+			},
+			// Configure SupportAssist successfully
+			{
+				PreConfig: func() {
+					if testMocker != nil {
+						testMocker.UnPatch()
+					}
+				},
+				Config: ProviderConfigForTesting + supportAssistGatewayConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_support_assist.test", "registered", "true"),
+					resource.TestCheckResourceAttr("powerflex_support_assist.test", "connection_status", "Connected"),
+					resource.TestCheckResourceAttr("powerflex_support_assist.test", "gateway_port", "9443"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if getConfigMocker != nil {
+				getConfigMocker.UnPatch()
+			}
+			if getStatusMocker != nil {
+				getStatusMocker.UnPatch()
+			}
+			if disableMocker != nil {
+				disableMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
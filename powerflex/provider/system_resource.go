@@ -24,6 +24,7 @@ import (
 
 	"github.com/dell/goscaleio"
 	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -31,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -39,9 +42,10 @@ import (
 )
 
 var (
-	_ resource.Resource                = &systemResource{}
-	_ resource.ResourceWithConfigure   = &systemResource{}
-	_ resource.ResourceWithImportState = &systemResource{}
+	_ resource.Resource                   = &systemResource{}
+	_ resource.ResourceWithConfigure      = &systemResource{}
+	_ resource.ResourceWithImportState    = &systemResource{}
+	_ resource.ResourceWithValidateConfig = &systemResource{}
 )
 
 // SystemResource - function to return resource interface
@@ -89,6 +93,24 @@ func (r *systemResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.system = system
 }
 
+// ValidateConfig - function to validate the capacity alert thresholds of system resource.
+func (r *systemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.SystemModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if helper.Known(config.CapacityAlertHighThreshold, config.CapacityAlertCriticalThreshold) &&
+		config.CapacityAlertHighThreshold.ValueInt64() >= config.CapacityAlertCriticalThreshold.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("capacity_alert_high_threshold"),
+			"Invalid capacity alert thresholds",
+			"capacity_alert_high_threshold must be lower than capacity_alert_critical_threshold",
+		)
+	}
+}
+
 // SystemResourceSchema defines the schema for system resource
 var SystemResourceSchema schema.Schema = schema.Schema{
 	Description:         "This resource is used to manage the cluster level operations of the PowerFlex Array. This resource supports Create, Update and Delete operations.",
@@ -151,6 +173,82 @@ var SystemResourceSchema schema.Schema = schema.Schema{
 				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
 			},
 		},
+		"capacity_alert_high_threshold": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Cluster wide threshold in percentage for triggering capacity usage high-priority alert. Must be lower than `capacity_alert_critical_threshold`.",
+			MarkdownDescription: "Cluster wide threshold in percentage for triggering capacity usage high-priority alert. Must be lower than `capacity_alert_critical_threshold`.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 99),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"capacity_alert_critical_threshold": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Cluster wide threshold in percentage for triggering capacity usage critical-priority alert.",
+			MarkdownDescription: "Cluster wide threshold in percentage for triggering capacity usage critical-priority alert.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 99),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"default_performance_profile": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Default performance profile of the system, which is applied to all the SDSs and SDCs of the system. Accepted values are `Compact`, `HighPerformance`." +
+				" It is empty in the state when the SDSs and SDCs do not share the same profile." +
+				" The performance profile of the MDM cluster is managed by `powerflex_mdm_cluster`.",
+			MarkdownDescription: "Default performance profile of the system, which is applied to all the SDSs and SDCs of the system. Accepted values are `Compact`, `HighPerformance`." +
+				" It is empty in the state when the SDSs and SDCs do not share the same profile." +
+				" The performance profile of the MDM cluster is managed by `powerflex_mdm_cluster`.",
+			Validators: []validator.String{stringvalidator.OneOf(
+				scaleiotypes.PerformanceProfileCompact,
+				scaleiotypes.PerformanceProfileHigh,
+			),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sdc_reconnect_interval": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Interval in seconds after which a disconnected SDC tries to reconnect to the MDM.",
+			MarkdownDescription: "Interval in seconds after which a disconnected SDC tries to reconnect to the MDM.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"allow_multiple_mappings": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			Description:         "Specifies whether a volume can be mapped to multiple SDCs by default.",
+			MarkdownDescription: "Specifies whether a volume can be mapped to multiple SDCs by default.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"sdc_authentication_enabled": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Specifies whether CHAP authentication of SDCs with the MDM is enabled." +
+				" CHAP secrets should be configured on the SDCs before enabling, otherwise the SDCs get disconnected." +
+				" CHAP secret of a linux SDC can be configured using `enable_authentication` of `powerflex_sdc_host`.",
+			MarkdownDescription: "Specifies whether CHAP authentication of SDCs with the MDM is enabled." +
+				" CHAP secrets should be configured on the SDCs before enabling, otherwise the SDCs get disconnected." +
+				" CHAP secret of a linux SDC can be configured using `enable_authentication` of `powerflex_sdc_host`.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
 		"sdc_approved_ips": schema.ListNestedAttribute{
 			Optional:            true,
 			Description:         "Specifies list of SDC IPs.",
@@ -258,15 +356,28 @@ func (r *systemResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	// Set cluster wide defaults
+	resp.Diagnostics.Append(helper.UpdateSystemMdmSettings(r.client, r.system, &plan, nil)...)
+
 	systems, err = r.client.GetInstance("")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance on the PowerFlex cluster",
 			"Could not get system instance, unexpected err: "+err.Error(),
 		)
+		return
 	}
 
-	state, diags := helper.UpdateSystemState(&plan, systems[0], r.system)
+	settings, err := helper.GetSystemMdmSettings(r.client, systems[0].ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting MDM settings of the system",
+			"Could not get MDM settings, unexpected err: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags := helper.UpdateSystemState(&plan, systems[0], settings, r.system)
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, state)
@@ -296,9 +407,19 @@ func (r *systemResource) Read(ctx context.Context, req resource.ReadRequest, res
 			"Error in getting system instance on the PowerFlex cluster",
 			"Could not get system instance, unexpected err: "+err.Error(),
 		)
+		return
 	}
 
-	state, diags = helper.UpdateSystemState(state, systems[0], r.system)
+	settings, err := helper.GetSystemMdmSettings(r.client, systems[0].ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting MDM settings of the system",
+			"Could not get MDM settings, unexpected err: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = helper.UpdateSystemState(state, systems[0], settings, r.system)
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, state)
@@ -347,12 +468,16 @@ func (r *systemResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	// Update cluster wide defaults
+	resp.Diagnostics.Append(helper.UpdateSystemMdmSettings(r.client, r.system, &plan, state)...)
+
 	systems, err := r.client.GetInstance("")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error in getting system instance on the PowerFlex cluster",
 			"Could not get system instance, unexpected err: "+err.Error(),
 		)
+		return
 	}
 
 	planSdcGuids := make([]string, 0)
@@ -435,7 +560,16 @@ func (r *systemResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	}
 
-	state, diags = helper.UpdateSystemState(&plan, systems[0], r.system)
+	settings, err := helper.GetSystemMdmSettings(r.client, systems[0].ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting MDM settings of the system",
+			"Could not get MDM settings, unexpected err: "+err.Error(),
+		)
+		return
+	}
+
+	state, diags = helper.UpdateSystemState(&plan, systems[0], settings, r.system)
 	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, state)
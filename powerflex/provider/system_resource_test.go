@@ -23,6 +23,8 @@ import (
 	"regexp"
 	"testing"
 
+	"terraform-provider-powerflex/powerflex/helper"
+
 	. "github.com/bytedance/mockey"
 	"github.com/dell/goscaleio"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -74,6 +76,16 @@ func TestAccResourceSystem(t *testing.T) {
 					resource.TestCheckResourceAttr("powerflex_system.test", "restricted_mode", "None"),
 				),
 			},
+			{
+				Config: ProviderConfigForTesting + SystemResourceConfigMdmSettings,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_system.test", "capacity_alert_high_threshold", "75"),
+					resource.TestCheckResourceAttr("powerflex_system.test", "capacity_alert_critical_threshold", "85"),
+					resource.TestCheckResourceAttr("powerflex_system.test", "default_performance_profile", "HighPerformance"),
+					resource.TestCheckResourceAttr("powerflex_system.test", "sdc_reconnect_interval", "30"),
+					resource.TestCheckResourceAttr("powerflex_system.test", "allow_multiple_mappings", "true"),
+				),
+			},
 		},
 	})
 }
@@ -204,10 +216,80 @@ func TestAccResourceSystemUT(t *testing.T) {
 					resource.TestCheckResourceAttr("powerflex_system.test", "restricted_mode", "None"),
 				),
 			},
+			// 14 invalid capacity alert threshold
+			{
+				Config:      ProviderConfigForTesting + SystemResourceConfigInvalidThreshold,
+				ExpectError: regexp.MustCompile(`.*Attribute capacity_alert_high_threshold value must be between*.`),
+			},
+			// 15 high capacity alert threshold not lower than critical threshold
+			{
+				Config:      ProviderConfigForTesting + SystemResourceConfigThresholdOrder,
+				ExpectError: regexp.MustCompile(`.*Invalid capacity alert thresholds*.`),
+			},
+			// 16 set capacity alert thresholds Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetSystemCapacityAlertThresholds).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + SystemResourceConfigMdmSettings,
+				ExpectError: regexp.MustCompile(`.*Error in setting capacity alert thresholds*.`),
+			},
+			// 17 set default performance profile Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetSystemDefaultPerformanceProfile).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + SystemResourceConfigMdmSettings,
+				ExpectError: regexp.MustCompile(`.*Error in setting default performance profile*.`),
+			},
+			// 18 set SDC authentication Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.SetSdcAuthentication).Return(fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + SystemResourceConfigSdcAuthentication,
+				ExpectError: regexp.MustCompile(`.*Error in setting SDC authentication*.`),
+			},
+			// 19 get MDM settings Error
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.GetSystemMdmSettings).Return(nil, fmt.Errorf("Mock error")).Build()
+				},
+				Config:      ProviderConfigForTesting + SystemResourceConfigMdmSettings,
+				ExpectError: regexp.MustCompile(`.*Error in getting MDM settings of the system*.`),
+			},
 		},
 	})
 }
 
+// UT
+func TestSystemDefaultPerformanceProfile(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a unit test")
+	}
+	if profile := helper.GetCommonPerformanceProfile([]string{"HighPerformance", "HighPerformance"}); profile != "HighPerformance" {
+		t.Fatalf("expected the shared profile, got %s", profile)
+	}
+	if profile := helper.GetCommonPerformanceProfile([]string{"HighPerformance", "Compact"}); profile != "" {
+		t.Fatalf("expected no profile when the SDSs and SDCs differ, got %s", profile)
+	}
+	if profile := helper.GetCommonPerformanceProfile(nil); profile != "" {
+		t.Fatalf("expected no profile without SDSs and SDCs, got %s", profile)
+	}
+}
+
 var SearchSdcBasedOnName = `
 	data "powerflex_sdc" "selected" {
 		filter {
@@ -301,3 +383,36 @@ resource "powerflex_system" "test" {
 	sdc_guids = ["invalid_guid"]
 }
 `
+
+var SystemResourceConfigMdmSettings = `
+resource "powerflex_system" "test" {
+	restricted_mode = "None"
+	capacity_alert_high_threshold = 75
+	capacity_alert_critical_threshold = 85
+	default_performance_profile = "HighPerformance"
+	sdc_reconnect_interval = 30
+	allow_multiple_mappings = true
+}
+`
+
+var SystemResourceConfigInvalidThreshold = `
+resource "powerflex_system" "test" {
+	restricted_mode = "None"
+	capacity_alert_high_threshold = 100
+}
+`
+
+var SystemResourceConfigThresholdOrder = `
+resource "powerflex_system" "test" {
+	restricted_mode = "None"
+	capacity_alert_high_threshold = 90
+	capacity_alert_critical_threshold = 80
+}
+`
+
+var SystemResourceConfigSdcAuthentication = `
+resource "powerflex_system" "test" {
+	restricted_mode = "None"
+	sdc_authentication_enabled = true
+}
+`
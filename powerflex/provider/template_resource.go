@@ -0,0 +1,247 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &templateResource{}
+	_ resource.ResourceWithConfigure   = &templateResource{}
+	_ resource.ResourceWithImportState = &templateResource{}
+)
+
+// NewTemplateResource - function to return resource interface
+func NewTemplateResource() resource.Resource {
+	return &templateResource{}
+}
+
+// templateResource - struct to define template resource
+type templateResource struct {
+	client        *goscaleio.Client
+	gatewayClient *goscaleio.GatewayClient
+}
+
+// Metadata - function to return metadata for template resource.
+func (r *templateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template"
+}
+
+// Schema - function to return Schema for template resource.
+func (r *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = TemplateResourceSchema
+}
+
+// Configure - function to return Configuration for template resource.
+func (r *templateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	if req.ProviderData.(*powerflexProvider).client == nil || req.ProviderData.(*powerflexProvider).gatewayClient == nil {
+		resp.Diagnostics.AddError("Unable to Authenticate Goscaleio API Client", req.ProviderData.(*powerflexProvider).clientError)
+		return
+	}
+
+	r.client = req.ProviderData.(*powerflexProvider).client
+	r.gatewayClient = req.ProviderData.(*powerflexProvider).gatewayClient
+}
+
+// Create - function to create the template.
+func (r *templateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "In create operation")
+	var plan models.TemplateResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaults, err := helper.GetTemplateDefaultComponents(r.gatewayClient, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting the default template components",
+			err.Error(),
+		)
+		return
+	}
+
+	payload, diags := helper.GetTemplatePayload(ctx, plan, nil, defaults)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := helper.CreateTemplate(r.gatewayClient, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating template",
+			err.Error(),
+		)
+		return
+	}
+
+	state, template, diags := r.read(ctx, id, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkTemplateParameters(ctx, plan, template)...)
+}
+
+// Read - function to read the template.
+func (r *templateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "In read operation")
+	var state models.TemplateResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, _, diags = r.read(ctx, state.ID.ValueString(), state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update - function to update the template.
+func (r *templateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "In update operation")
+	var plan, state models.TemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := helper.GetTemplatePayloadByID(r.gatewayClient, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting template details",
+			err.Error(),
+		)
+		return
+	}
+
+	defaults, err := helper.GetTemplateDefaultComponents(r.gatewayClient, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error in getting the default template components",
+			err.Error(),
+		)
+		return
+	}
+
+	payload, diags := helper.GetTemplatePayload(ctx, plan, existing, defaults)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = helper.UpdateTemplate(r.gatewayClient, r.client, state.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating template",
+			err.Error(),
+		)
+		return
+	}
+
+	state, template, diags := r.read(ctx, state.ID.ValueString(), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(checkTemplateParameters(ctx, plan, template)...)
+}
+
+// Delete - function to delete the template.
+func (r *templateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "In delete operation")
+	var state models.TemplateResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := helper.DeleteTemplate(r.gatewayClient, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting template",
+			err.Error(),
+		)
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState - function to import the template.
+func (r *templateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// read gets the template and sets it in the state
+func (r *templateResource) read(ctx context.Context, id string, plan models.TemplateResourceModel) (models.TemplateResourceModel, *scaleiotypes.TemplateDetails, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	template, err := r.gatewayClient.GetTemplateByID(id)
+	if err != nil {
+		diags.AddError(
+			"Error in getting template details",
+			err.Error(),
+		)
+		return plan, nil, diags
+	}
+	state, diags := helper.UpdateTemplateState(ctx, plan, template)
+	return state, template, diags
+}
+
+// checkTemplateParameters reports the declared parameters which are not returned by PowerFlex Manager after the template is applied
+func checkTemplateParameters(ctx context.Context, plan models.TemplateResourceModel, template *scaleiotypes.TemplateDetails) diag.Diagnostics {
+	missing, diags := helper.GetTemplateMissingParameters(ctx, plan, template)
+	if len(missing) > 0 {
+		diags.AddError(
+			"Error in template parameters",
+			"the following parameters are not returned by PowerFlex Manager, they are not supported by the components or have been renamed: "+strings.Join(missing, ", "),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,179 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"terraform-provider-powerflex/powerflex/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TemplateResourceSchema defines the schema for template resource
+var TemplateResourceSchema schema.Schema = schema.Schema{
+	Description: "This resource is used to author the templates of PowerFlex Manager, with their node and PowerFlex cluster components, network, OS image and firmware repository references." +
+		" New components are created from the default definition of their type in PowerFlex Manager." +
+		" Only the declared parameters of the components are managed, the other parameters keep the values set by PowerFlex Manager." +
+		" Components of other types are left untouched." +
+		" This resource supports Create, Update, Delete and Import operations.",
+	MarkdownDescription: "This resource is used to author the templates of PowerFlex Manager, with their node and PowerFlex cluster components, network, OS image and firmware repository references." +
+		" New components are created from the default definition of their type in PowerFlex Manager." +
+		" Only the declared parameters of the components are managed, the other parameters keep the values set by PowerFlex Manager." +
+		" Components of other types are left untouched." +
+		" This resource supports Create, Update, Delete and Import operations.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the template.",
+			MarkdownDescription: "ID of the template.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Description:         "Name of the template.",
+			MarkdownDescription: "Name of the template.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"description": schema.StringAttribute{
+			Description:         "Description of the template.",
+			MarkdownDescription: "Description of the template.",
+			Optional:            true,
+		},
+		"category": schema.StringAttribute{
+			Description:         "Category of the template.",
+			MarkdownDescription: "Category of the template.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"firmware_repository_id": schema.StringAttribute{
+			Description:         "ID of the firmware repository used to manage the firmware of the nodes. If not specified, the firmware is not managed.",
+			MarkdownDescription: "ID of the firmware repository used to manage the firmware of the nodes. If not specified, the firmware is not managed.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"network_ids": schema.SetAttribute{
+			Description:         "IDs of the networks assigned to the template.",
+			MarkdownDescription: "IDs of the networks assigned to the template.",
+			Optional:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		},
+		"published": schema.BoolAttribute{
+			Description:         "Whether the template is published, so that it can be deployed by resource groups. Default value is `false`, the template is kept as a draft.",
+			MarkdownDescription: "Whether the template is published, so that it can be deployed by resource groups. Default value is `false`, the template is kept as a draft.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"node_count": schema.Int64Attribute{
+			Description:         "Number of nodes of the template.",
+			MarkdownDescription: "Number of nodes of the template.",
+			Computed:            true,
+		},
+		"components": schema.ListNestedAttribute{
+			Description:         "Node and PowerFlex cluster components of the template.",
+			MarkdownDescription: "Node and PowerFlex cluster components of the template.",
+			Required:            true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description:         "ID of the component.",
+						MarkdownDescription: "ID of the component.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						Description:         "Name of the component. Components are matched by name on update.",
+						MarkdownDescription: "Name of the component. Components are matched by name on update.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"type": schema.StringAttribute{
+						Description:         "Type of the component. Accepted values are `SERVER` for nodes and `SCALEIO` for the PowerFlex cluster. Type cannot be changed.",
+						MarkdownDescription: "Type of the component. Accepted values are `SERVER` for nodes and `SCALEIO` for the PowerFlex cluster. Type cannot be changed.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(helper.TemplateComponentNode, helper.TemplateComponentCluster),
+						},
+					},
+					"instances": schema.Int64Attribute{
+						Description:         "Number of nodes of the component. Only applicable to `SERVER` components.",
+						MarkdownDescription: "Number of nodes of the component. Only applicable to `SERVER` components.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"os_image": schema.StringAttribute{
+						Description:         "Name of the OS image repository installed on the nodes. Only applicable to `SERVER` components.",
+						MarkdownDescription: "Name of the OS image repository installed on the nodes. Only applicable to `SERVER` components.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"parameters": schema.MapAttribute{
+						Description:         "Parameters of the component by ID, for example `scaleio_role` of the nodes or `protection_domain` of the PowerFlex cluster. The apply fails if a parameter is not returned by PowerFlex Manager.",
+						MarkdownDescription: "Parameters of the component by ID, for example `scaleio_role` of the nodes or `protection_domain` of the PowerFlex cluster. The apply fails if a parameter is not returned by PowerFlex Manager.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"related_components": schema.SetAttribute{
+						Description:         "Names of the components related to the component.",
+						MarkdownDescription: "Names of the components related to the component.",
+						Optional:            true,
+						Computed:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,296 @@
+/*
+Copyright (c) 2025 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"terraform-provider-powerflex/powerflex/helper"
+	"terraform-provider-powerflex/powerflex/models"
+	"testing"
+
+	. "github.com/bytedance/mockey"
+	"github.com/dell/goscaleio"
+	scaleiotypes "github.com/dell/goscaleio/types/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var templateResourceConfig = `
+resource "powerflex_template" "test" {
+	name = "hci-template"
+	description = "HCI template"
+	firmware_repository_id = "8aaa80658cd602e0018cd996a1c91bdc"
+	published = true
+	components = [
+		{
+			name = "Node"
+			type = "SERVER"
+			instances = 3
+			os_image = "os-repo"
+			parameters = {
+				scaleio_role = "hci"
+			}
+		},
+		{
+			name = "PowerFlex Cluster"
+			type = "SCALEIO"
+			related_components = ["Node"]
+		}
+	]
+}
+`
+
+var templateResourceInvalidTypeConfig = `
+resource "powerflex_template" "test" {
+	name = "hci-template"
+	components = [
+		{
+			name = "VM"
+			type = "VIRTUALMACHINE"
+		}
+	]
+}
+`
+
+var templateResourceMock = &scaleiotypes.TemplateDetails{
+	ID:                  "9bd9b8cf-f7bd-4e5d-a0c4-0e5c6e4e5b74",
+	TemplateName:        "hci-template",
+	TemplateDescription: "HCI template",
+	Category:            "Sample",
+	ManageFirmware:      true,
+	FirmwareRepository:  scaleiotypes.FirmwareRepository{ID: "8aaa80658cd602e0018cd996a1c91bdc"},
+	ServerCount:         3,
+	Components: []scaleiotypes.Components{
+		{
+			ID:        "node-component",
+			Name:      "Node",
+			Type:      "SERVER",
+			Instances: 3,
+			Resources: []scaleiotypes.Resources{
+				{
+					ID: "asm::server",
+					Parameters: []scaleiotypes.ParametersDetails{
+						{ID: "razor_image", Value: "os-repo"},
+						{ID: "scaleio_role", Value: "hci"},
+						{ID: "os_host_name", Value: ""},
+					},
+				},
+			},
+		},
+		{
+			ID:                "cluster-component",
+			Name:              "PowerFlex Cluster",
+			Type:              "SCALEIO",
+			RelatedComponents: map[string]string{"node-component": "Node"},
+		},
+	},
+}
+
+var templateDefaultComponentsMock = map[string]map[string]interface{}{
+	"SERVER": {
+		"id":          "default-server",
+		"componentID": "component-server-1",
+		"type":        "SERVER",
+		"resources": []interface{}{
+			map[string]interface{}{
+				"id": "asm::server",
+				"parameters": []interface{}{
+					map[string]interface{}{"id": "scaleio_role", "value": "hci"},
+					map[string]interface{}{"id": "os_host_name", "value": nil},
+				},
+			},
+		},
+	},
+	"SCALEIO": {"id": "default-scaleio", "componentID": "component-scaleio-1", "type": "SCALEIO"},
+}
+
+// UT
+func TestAccResourceTemplateUT(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is an Unit test")
+	}
+	var defaultsMocker, getMocker, deleteMocker *Mocker
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Only node and PowerFlex cluster components are managed
+			{
+				Config:      ProviderConfigForTesting + templateResourceInvalidTypeConfig,
+				ExpectError: regexp.MustCompile(`.*value must be one of*.`),
+			},
+			// Error creating the template
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateTemplate).Return("", fmt.Errorf("Mock error")).Build()
+					defaultsMocker = Mock(helper.GetTemplateDefaultComponents).Return(templateDefaultComponentsMock, nil).Build()
+				},
+				Config:      ProviderConfigForTesting + templateResourceConfig,
+				ExpectError: regexp.MustCompile(`.*Error creating template*.`),
+			},
+			// Create the template
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.UnPatch()
+					}
+					FunctionMocker = Mock(helper.CreateTemplate).Return(templateResourceMock.ID, nil).Build()
+					getMocker = Mock((*goscaleio.GatewayClient).GetTemplateByID).Return(templateResourceMock, nil).Build()
+					deleteMocker = Mock(helper.DeleteTemplate).Return(nil).Build()
+				},
+				Config: ProviderConfigForTesting + templateResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerflex_template.test", "id", templateResourceMock.ID),
+					resource.TestCheckResourceAttr("powerflex_template.test", "node_count", "3"),
+					resource.TestCheckResourceAttr("powerflex_template.test", "components.0.id", "node-component"),
+					resource.TestCheckResourceAttr("powerflex_template.test", "components.0.parameters.%", "1"),
+					resource.TestCheckResourceAttr("powerflex_template.test", "components.1.related_components.0", "Node"),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			if defaultsMocker != nil {
+				defaultsMocker.UnPatch()
+			}
+			if getMocker != nil {
+				getMocker.UnPatch()
+			}
+			if deleteMocker != nil {
+				deleteMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
+// UT
+func TestTemplatePayload(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("Dont run with acceptance tests, this is a Unit test")
+	}
+	component := func(name, componentType string, params map[string]attr.Value) attr.Value {
+		return types.ObjectValueMust(helper.TemplateComponentAttrTypes, map[string]attr.Value{
+			"id":                 types.StringUnknown(),
+			"name":               types.StringValue(name),
+			"type":               types.StringValue(componentType),
+			"instances":          types.Int64Unknown(),
+			"os_image":           types.StringUnknown(),
+			"parameters":         types.MapValueMust(types.StringType, params),
+			"related_components": types.SetUnknown(types.StringType),
+		})
+	}
+	plan := models.TemplateResourceModel{
+		Name:       types.StringValue("hci-template"),
+		Published:  types.BoolValue(true),
+		NetworkIDs: types.SetNull(types.StringType),
+		Components: types.ListValueMust(types.ObjectType{AttrTypes: helper.TemplateComponentAttrTypes}, []attr.Value{
+			component("Node", "SERVER", map[string]attr.Value{"scaleio_role": types.StringValue("storage")}),
+		}),
+	}
+	existing := map[string]interface{}{
+		"components": []interface{}{
+			map[string]interface{}{
+				"id":   "node-component",
+				"name": "Node",
+				"type": "SERVER",
+				"resources": []interface{}{
+					map[string]interface{}{
+						"id": "asm::server",
+						"parameters": []interface{}{
+							map[string]interface{}{"id": "scaleio_role", "value": "hci"},
+							map[string]interface{}{"id": "os_host_name", "value": "node-1"},
+						},
+					},
+				},
+			},
+			map[string]interface{}{"id": "vm-component", "name": "VM", "type": "VIRTUALMACHINE"},
+		},
+	}
+
+	// the declared parameters are updated and the other parameters and components are preserved
+	payload, diags := helper.GetTemplatePayload(context.Background(), plan, existing, templateDefaultComponentsMock)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if payload["draft"] != false {
+		t.Fatalf("expected the template to be published, got draft %v", payload["draft"])
+	}
+	components := payload["components"].([]interface{})
+	if len(components) != 2 {
+		t.Fatalf("expected 2 components, got %d", len(components))
+	}
+	node := components[1].(map[string]interface{})
+	if node["id"] != "node-component" {
+		t.Fatalf("expected the node component to keep its ID, got %v", node["id"])
+	}
+	parameters := node["resources"].([]interface{})[0].(map[string]interface{})["parameters"].([]interface{})
+	if len(parameters) != 2 || parameters[0].(map[string]interface{})["value"] != "storage" {
+		t.Fatalf("expected scaleio_role to be updated and os_host_name to be preserved, got %v", parameters)
+	}
+
+	// the type of a component cannot be changed
+	plan.Components = types.ListValueMust(types.ObjectType{AttrTypes: helper.TemplateComponentAttrTypes}, []attr.Value{
+		component("Node", "SCALEIO", map[string]attr.Value{}),
+	})
+	if _, diags := helper.GetTemplatePayload(context.Background(), plan, existing, templateDefaultComponentsMock); !diags.HasError() {
+		t.Fatal("expected error when changing the type of a component")
+	}
+
+	// a new component is seeded from the default definition of its type
+	plan.Components = types.ListValueMust(types.ObjectType{AttrTypes: helper.TemplateComponentAttrTypes}, []attr.Value{
+		component("Storage Node", "SERVER", map[string]attr.Value{"scaleio_role": types.StringValue("storage")}),
+	})
+	payload, diags = helper.GetTemplatePayload(context.Background(), plan, nil, templateDefaultComponentsMock)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	node = payload["components"].([]interface{})[0].(map[string]interface{})
+	if node["id"] == "default-server" || node["componentID"] != "component-server-1" {
+		t.Fatalf("expected the new component to be seeded from the default definition with a new ID, got %v", node)
+	}
+	parameters = node["resources"].([]interface{})[0].(map[string]interface{})["parameters"].([]interface{})
+	if len(parameters) != 2 || parameters[0].(map[string]interface{})["value"] != "storage" {
+		t.Fatalf("expected scaleio_role to be set on the default parameters, got %v", parameters)
+	}
+	if templateDefaultComponentsMock["SERVER"]["resources"].([]interface{})[0].(map[string]interface{})["parameters"].([]interface{})[0].(map[string]interface{})["value"] != "hci" {
+		t.Fatal("expected the default definition not to be modified")
+	}
+	if _, diags := helper.GetTemplatePayload(context.Background(), plan, nil, nil); !diags.HasError() {
+		t.Fatal("expected error when the default definition of the component is not returned")
+	}
+
+	// declared parameters which are not returned by PowerFlex Manager are reported
+	plan.Components = types.ListValueMust(types.ObjectType{AttrTypes: helper.TemplateComponentAttrTypes}, []attr.Value{
+		component("Node", "SERVER", map[string]attr.Value{"scaleio_role": types.StringValue("hci"), "sds_role": types.StringValue("all")}),
+	})
+	missing, diags := helper.GetTemplateMissingParameters(context.Background(), plan, templateResourceMock)
+	if diags.HasError() || len(missing) != 1 || missing[0] != "Node/sds_role" {
+		t.Fatalf("expected Node/sds_role to be missing, got %v %v", missing, diags)
+	}
+}